@@ -0,0 +1,52 @@
+package napi
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// buildTestAddon builds the addon in testdata/name and returns its path.
+func buildTestAddon(t *testing.T, name string) string {
+	t.Helper()
+
+	if testing.Short() {
+		t.Skip("skipping addon test in short mode")
+	}
+	if _, err := exec.LookPath("node"); err != nil {
+		t.Skip("node is not installed")
+	}
+
+	addon := filepath.Join(t.TempDir(), name+".node")
+	cmd := exec.Command("go", "build", "-buildmode=c-shared", "-o", addon, "./testdata/"+name)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("failed to build addon: %v\n%s", err, out)
+	}
+
+	return addon
+}
+
+// runTestAddon runs script with node, with the path of addon as
+// process.argv[1], and returns what it writes to stdout.
+func runTestAddon(t *testing.T, addon, script string) string {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "node", "-e", script, addon)
+	cmd.Stderr = os.Stderr
+	out, err := cmd.Output()
+	if ctx.Err() != nil {
+		t.Fatal("node timed out")
+	}
+	if err != nil {
+		t.Fatalf("node failed: %v", err)
+	}
+
+	return strings.TrimSpace(string(out))
+}
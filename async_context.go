@@ -0,0 +1,88 @@
+package napi
+
+/*
+#include <node/node_api.h>
+*/
+import "C"
+
+import (
+	"runtime"
+	"unsafe"
+)
+
+type AsyncContext unsafe.Pointer
+
+type CallbackScope unsafe.Pointer
+
+func AsyncInit(
+	env Env,
+	asyncResource, asyncResourceName Value,
+) (AsyncContext, Status) {
+	var result AsyncContext
+	status := Status(C.napi_async_init(
+		C.napi_env(env),
+		C.napi_value(asyncResource),
+		C.napi_value(asyncResourceName),
+		(*C.napi_async_context)(unsafe.Pointer(&result)),
+	))
+	return result, status
+}
+
+func AsyncDestroy(env Env, asyncContext AsyncContext) Status {
+	return Status(C.napi_async_destroy(
+		C.napi_env(env),
+		C.napi_async_context(asyncContext),
+	))
+}
+
+func MakeCallback(
+	env Env,
+	asyncContext AsyncContext,
+	recv, fn Value,
+	args []Value,
+) (Value, Status) {
+	defer runtime.KeepAlive(args)
+
+	var argsPtr *C.napi_value
+	if len(args) > 0 {
+		argsPtr = (*C.napi_value)(unsafe.Pointer(&args[0]))
+	}
+
+	var result Value
+	status := Status(C.napi_make_callback(
+		C.napi_env(env),
+		C.napi_async_context(asyncContext),
+		C.napi_value(recv),
+		C.napi_value(fn),
+		C.size_t(len(args)),
+		argsPtr,
+		(*C.napi_value)(unsafe.Pointer(&result)),
+	))
+	if status != StatusOK {
+		return nil, status
+	}
+
+	return result, status
+}
+
+func OpenCallbackScope(
+	env Env,
+	resourceObject Value,
+	asyncContext AsyncContext,
+) (CallbackScope, Status) {
+	var result CallbackScope
+	status := Status(C.napi_open_callback_scope(
+		C.napi_env(env),
+		C.napi_value(resourceObject),
+		C.napi_async_context(asyncContext),
+		(*C.napi_callback_scope)(unsafe.Pointer(&result)),
+	))
+	return result, status
+}
+
+func CloseCallbackScope(env Env, scope CallbackScope) Status {
+	return Status(C.napi_close_callback_scope(
+		C.napi_env(env),
+		C.napi_callback_scope(scope),
+	))
+}
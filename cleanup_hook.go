@@ -0,0 +1,128 @@
+package napi
+
+/*
+#include <node/node_api.h>
+
+extern void napiGoCallEnvCleanupHook(void *arg);
+
+extern void napiGoCallAsyncCleanupHook(
+	napi_async_cleanup_hook_handle handle,
+	void *arg
+);
+*/
+import "C"
+
+import (
+	"runtime/cgo"
+	"sync"
+	"unsafe"
+)
+
+// EnvCleanupHookFn is invoked when the env shuts down, e.g. when a worker
+// thread exits. It must not call into JS.
+type EnvCleanupHookFn func()
+
+// EnvCleanupHook identifies a hook added with AddEnvCleanupHook.
+type EnvCleanupHook unsafe.Pointer
+
+// AsyncCleanupHookFn is invoked when the env shuts down, and may complete its
+// cleanup asynchronously. Shutdown is delayed until RemoveAsyncCleanupHook is
+// called with handle.
+type AsyncCleanupHookFn func(handle AsyncCleanupHookHandle)
+
+type AsyncCleanupHookHandle unsafe.Pointer
+
+// asyncCleanupHooks maps each AsyncCleanupHookHandle to the cgo.Handle of its
+// AsyncCleanupHookFn, so either running or removing the hook can release it.
+var asyncCleanupHooks sync.Map
+
+//export napiGoCallEnvCleanupHook
+func napiGoCallEnvCleanupHook(arg unsafe.Pointer) {
+	handle := cgo.Handle(arg)
+	fn := handle.Value().(EnvCleanupHookFn)
+	handle.Delete()
+
+	fn()
+}
+
+//export napiGoCallAsyncCleanupHook
+func napiGoCallAsyncCleanupHook(
+	cHandle C.napi_async_cleanup_hook_handle,
+	arg unsafe.Pointer,
+) {
+	fn := cgo.Handle(arg).Value().(AsyncCleanupHookFn)
+	releaseAsyncCleanupHook(AsyncCleanupHookHandle(cHandle))
+
+	fn(AsyncCleanupHookHandle(cHandle))
+}
+
+var _cCallEnvCleanupHook = C.napiGoCallEnvCleanupHook
+var _cCallAsyncCleanupHook = C.napiGoCallAsyncCleanupHook
+
+func AddEnvCleanupHook(env Env, fn EnvCleanupHookFn) (EnvCleanupHook, Status) {
+	arg := unsafe.Pointer(cgo.NewHandle(fn))
+	status := Status(C.napi_add_env_cleanup_hook(
+		C.napi_env(env),
+		(*[0]byte)(_cCallEnvCleanupHook),
+		arg,
+	))
+
+	if status != StatusOK {
+		cgo.Handle(arg).Delete()
+		return nil, status
+	}
+
+	return EnvCleanupHook(arg), status
+}
+
+func RemoveEnvCleanupHook(env Env, hook EnvCleanupHook) Status {
+	status := Status(C.napi_remove_env_cleanup_hook(
+		C.napi_env(env),
+		(*[0]byte)(_cCallEnvCleanupHook),
+		unsafe.Pointer(hook),
+	))
+
+	if status == StatusOK {
+		cgo.Handle(hook).Delete()
+	}
+
+	return status
+}
+
+func AddAsyncCleanupHook(
+	env Env,
+	fn AsyncCleanupHookFn,
+) (AsyncCleanupHookHandle, Status) {
+	arg := cgo.NewHandle(fn)
+
+	var result AsyncCleanupHookHandle
+	status := Status(C.napi_add_async_cleanup_hook(
+		C.napi_env(env),
+		(*[0]byte)(_cCallAsyncCleanupHook),
+		unsafe.Pointer(arg),
+		(*C.napi_async_cleanup_hook_handle)(unsafe.Pointer(&result)),
+	))
+
+	if status != StatusOK {
+		arg.Delete()
+		return nil, status
+	}
+
+	asyncCleanupHooks.Store(result, arg)
+	return result, status
+}
+
+// RemoveAsyncCleanupHook removes a hook added with AddAsyncCleanupHook, or
+// signals that its asynchronous cleanup is complete if it has been invoked.
+func RemoveAsyncCleanupHook(handle AsyncCleanupHookHandle) Status {
+	releaseAsyncCleanupHook(handle)
+	return Status(C.napi_remove_async_cleanup_hook(
+		C.napi_async_cleanup_hook_handle(handle),
+	))
+}
+
+func releaseAsyncCleanupHook(handle AsyncCleanupHookHandle) {
+	if arg, ok := asyncCleanupHooks.LoadAndDelete(handle); ok {
+		arg.(cgo.Handle).Delete()
+	}
+}
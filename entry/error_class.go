@@ -0,0 +1,57 @@
+package entry
+
+import (
+	"fmt"
+
+	"github.com/akshayganeshen/napi-go/js"
+)
+
+// ExportErrorClass defines an error class named name with
+// js.Env.DefineErrorClass when the module is initialized, and exports it with
+// the same name, so JS can check errors with instanceof. Go errors are thrown
+// as instances of the class if they match a js.ErrorMapping with the same
+// ClassName.
+//
+// base optionally names the class to extend, either an error class defined
+// earlier, or a global such as "TypeError". By default, the class extends
+// Error.
+func ExportErrorClass(name string, base ...string) {
+	OnInit(func(env js.Env, exports js.Object) error {
+		bases := make([]js.Function, len(base))
+		for i, baseName := range base {
+			baseClass, err := lookupErrorClass(env, baseName)
+			if err != nil {
+				return fmt.Errorf("%s: base class %s: %w", name, baseName, err)
+			}
+
+			bases[i] = baseClass
+		}
+
+		cls, err := env.DefineErrorClass(name, bases...)
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+
+		return exports.SetNamed(name, cls)
+	})
+}
+
+func lookupErrorClass(env js.Env, name string) (js.Function, error) {
+	if cls, ok, err := env.DefinedErrorClass(name); err != nil {
+		return js.Function{}, err
+	} else if ok {
+		return cls, nil
+	}
+
+	global, err := env.GetGlobal()
+	if err != nil {
+		return js.Function{}, err
+	}
+
+	cls, err := global.GetNamed(name)
+	if err != nil {
+		return js.Function{}, err
+	}
+
+	return cls.AsFunction()
+}
@@ -0,0 +1,67 @@
+package entry
+
+import (
+	"sync"
+
+	"github.com/akshayganeshen/napi-go/js"
+)
+
+type InitFn func(env js.Env, exports js.Object) error
+
+type UnloadFn func(env js.Env)
+
+var (
+	lifecycleLock sync.Mutex
+
+	napiGoGlobalInitFns   []InitFn
+	napiGoGlobalUnloadFns []UnloadFn
+)
+
+// OnInit registers fn to run each time the module is initialized for an env,
+// after the functions registered with Export are added to exports. If fn
+// returns an error, it is thrown from the require call that loaded the module.
+//
+// To clean up after a single env, e.g. to close a resource opened for it, fn
+// can register a hook with env.AddCleanupHook. Those hooks run before the
+// functions registered with OnUnload.
+func OnInit(fn InitFn) {
+	lifecycleLock.Lock()
+	defer lifecycleLock.Unlock()
+
+	napiGoGlobalInitFns = append(napiGoGlobalInitFns, fn)
+}
+
+// OnUnload registers fn to run when any env that initialized the module shuts
+// down, e.g. when a worker thread exits or the process is exiting. Unload
+// functions run in the reverse order of registration, and must not call into
+// JS.
+func OnUnload(fn UnloadFn) {
+	lifecycleLock.Lock()
+	defer lifecycleLock.Unlock()
+
+	napiGoGlobalUnloadFns = append(napiGoGlobalUnloadFns, fn)
+}
+
+func runInitFns(env js.Env, exports js.Object) error {
+	lifecycleLock.Lock()
+	fns := napiGoGlobalInitFns
+	lifecycleLock.Unlock()
+
+	for _, fn := range fns {
+		if err := fn(env, exports); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func runUnloadFns(env js.Env) {
+	lifecycleLock.Lock()
+	fns := napiGoGlobalUnloadFns
+	lifecycleLock.Unlock()
+
+	for i := len(fns) - 1; i >= 0; i-- {
+		fns[i](env)
+	}
+}
@@ -0,0 +1,52 @@
+package entry
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/akshayganeshen/napi-go/js"
+)
+
+func TestLifecycleOrder(t *testing.T) {
+	defer func() {
+		napiGoGlobalInitFns = nil
+		napiGoGlobalUnloadFns = nil
+	}()
+
+	errInit := errors.New("init failed")
+
+	var calls []string
+	OnInit(func(env js.Env, exports js.Object) error {
+		calls = append(calls, "init 1")
+		return nil
+	})
+	OnInit(func(env js.Env, exports js.Object) error {
+		calls = append(calls, "init 2")
+		return errInit
+	})
+	OnInit(func(env js.Env, exports js.Object) error {
+		calls = append(calls, "init 3")
+		return nil
+	})
+	OnUnload(func(env js.Env) {
+		calls = append(calls, "unload 1")
+	})
+	OnUnload(func(env js.Env) {
+		calls = append(calls, "unload 2")
+	})
+
+	if err := runInitFns(js.Env{}, js.Object{}); err != errInit {
+		t.Errorf("runInitFns: got error %v; want %v", err, errInit)
+	}
+
+	if want := []string{"init 1", "init 2"}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("init functions ran as %v; want %v", calls, want)
+	}
+
+	calls = nil
+	runUnloadFns(js.Env{})
+	if want := []string{"unload 2", "unload 1"}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("unload functions ran as %v; want %v", calls, want)
+	}
+}
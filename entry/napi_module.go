@@ -9,12 +9,14 @@ import "C"
 
 import (
 	"github.com/akshayganeshen/napi-go"
+	"github.com/akshayganeshen/napi-go/js"
 )
 
 //export InitializeModule
 func InitializeModule(cEnv C.napi_env, cExports C.napi_value) C.napi_value {
 	env, exports := napi.Env(cEnv), napi.Value(cExports)
 	napi.InitializeInstanceData(env)
+	exportErrorCodes(env, exports)
 
 	for _, export := range napiGoGlobalExports {
 		cb, _ := napi.CreateFunction(env, export.Name, export.Callback)
@@ -22,5 +24,36 @@ func InitializeModule(cEnv C.napi_env, cExports C.napi_value) C.napi_value {
 		napi.SetProperty(env, exports, name, cb)
 	}
 
+	// the hook is added before running the init functions, so the hooks they
+	// add run first, and even if there are no unload functions yet, since they
+	// may be registered later
+	jsEnv := js.WrapEnv(env)
+	napi.AddEnvCleanupHook(env, func() {
+		runUnloadFns(jsEnv)
+	})
+
+	if err := runInitFns(jsEnv, jsEnv.WrapValue(exports).AsObjectUnsafe()); err != nil {
+		jsEnv.ThrowGoError(err)
+		return nil
+	}
+
 	return cExports
 }
+
+// exportErrorCodes sets exports.errorCodes to an object mapping each of the
+// napi.ErrorCodes to itself, like the codes exported by Node's own modules.
+// Functions exported with the same name take precedence.
+func exportErrorCodes(env napi.Env, exports napi.Value) {
+	codes, st := napi.CreateObject(env)
+	if st != napi.StatusOK {
+		return
+	}
+
+	for _, code := range napi.ErrorCodes() {
+		value, _ := napi.CreateStringUtf8(env, code)
+		napi.SetProperty(env, codes, value, value)
+	}
+
+	name, _ := napi.CreateStringUtf8(env, "errorCodes")
+	napi.SetProperty(env, exports, name, codes)
+}
@@ -5,6 +5,7 @@ package entry
 #cgo CFLAGS: -D_DEBUG
 #cgo CFLAGS: -DV8_ENABLE_CHECKS
 #cgo CFLAGS: -DNAPI_EXPERIMENTAL
+#cgo CFLAGS: -DNODE_API_EXPERIMENTAL_BASIC_ENV_OPT_OUT
 #cgo CFLAGS: -I/usr/local/include/node
 #cgo CXXFLAGS: -std=c++11
 
@@ -0,0 +1,68 @@
+package entry
+
+import (
+	"fmt"
+
+	"github.com/akshayganeshen/napi-go/js"
+)
+
+// ExportScript evaluates the JS script source when the module is initialized,
+// and merges the own enumerable properties of its result into exports. If the
+// script evaluates to a function, it is called with exports and its return
+// value is merged instead, e.g.
+//
+//	(exports) => ({
+//	  greet: (name = "world") => exports.hello(String(name)),
+//	})
+//
+// name identifies the script in stack traces and errors. Scripts run in the
+// order they are registered, along with functions registered with OnInit.
+//
+// source is typically embedded with go:embed.
+func ExportScript(name, source string) {
+	OnInit(func(env js.Env, exports js.Object) error {
+		if err := runExportScript(env, exports, name, source); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+
+		return nil
+	})
+}
+
+func runExportScript(env js.Env, exports js.Object, name, source string) error {
+	result, err := env.RunScript(source + "\n//# sourceURL=" + name + "\n")
+	if err != nil {
+		return err
+	}
+
+	if fn, err := result.AsFunction(); err == nil {
+		result, err = fn.Call(nil, exports)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ok, err := result.IsUndefined(); err != nil {
+		return err
+	} else if ok {
+		return nil
+	}
+
+	obj, err := result.AsObject()
+	if err != nil {
+		return fmt.Errorf("expected script result to be an object: %w", err)
+	}
+
+	entries, err := obj.Entries()
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if err := exports.Set(entry.Key, entry.Value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package napi
+
+import (
+	"errors"
+)
+
+// Error codes set as the code property of the errors thrown by napi-go, so JS
+// callers can branch on err.code rather than on messages. They are also
+// exported to JS as the errorCodes property of addons using package entry.
+const (
+	// ErrCodeArgCount is thrown (as a TypeError) when a Go function is called
+	// with too few or too many arguments.
+	ErrCodeArgCount = "ERR_NAPI_GO_ARG_COUNT"
+
+	// ErrCodeArgType is thrown (as a TypeError) when an argument, or 'this',
+	// cannot be converted to the type of the Go function's parameter.
+	ErrCodeArgType = "ERR_NAPI_GO_ARG_TYPE"
+
+	// ErrCodeResultType is thrown when the result of a Go function cannot be
+	// converted to a JS value.
+	ErrCodeResultType = "ERR_NAPI_GO_RESULT_TYPE"
+
+	// ErrCodePanic is thrown when a Go function panics.
+	ErrCodePanic = "ERR_NAPI_GO_PANIC"
+
+	// ErrCodeStatus is thrown when a Node-API call fails.
+	ErrCodeStatus = "ERR_NAPI_GO_STATUS"
+
+	// ErrCodeConstructor is thrown when a class defined in Go is called
+	// without new, or its constructor does not return an instance.
+	ErrCodeConstructor = "ERR_NAPI_GO_CONSTRUCTOR"
+
+	// ErrCodeGoError is thrown when a Go function returns an error that has
+	// no more specific code.
+	ErrCodeGoError = "ERR_NAPI_GO_ERROR"
+)
+
+// ErrorCodes returns all of the error codes thrown by napi-go.
+func ErrorCodes() []string {
+	return []string{
+		ErrCodeArgCount,
+		ErrCodeArgType,
+		ErrCodeResultType,
+		ErrCodePanic,
+		ErrCodeStatus,
+		ErrCodeConstructor,
+		ErrCodeGoError,
+	}
+}
+
+// ErrorCodeOf returns the error code to throw for err, i.e. ErrCodeStatus if
+// err is the result of a failed Node-API call, or ErrCodeGoError otherwise.
+func ErrorCodeOf(err error) string {
+	var statusErr StatusError
+	if errors.As(err, &statusErr) {
+		return ErrCodeStatus
+	}
+
+	return ErrCodeGoError
+}
@@ -0,0 +1,75 @@
+package napi
+
+/*
+#include <node/node_api.h>
+*/
+import "C"
+
+import (
+	"fmt"
+)
+
+// ExtendedError describes a failed Node-API call, including the information
+// reported by napi_get_last_error_info at the point of failure.
+type ExtendedError struct {
+	// Op is the name of the Node-API function that failed, e.g.
+	// "napi_set_property".
+	Op string
+
+	Status Status
+
+	// Message is the engine's description of the failure, if any.
+	Message string
+
+	// EngineErrorCode is the VM-specific error code, if any.
+	EngineErrorCode uint32
+}
+
+var _ error = &ExtendedError{}
+
+func (err *ExtendedError) Error() string {
+	msg := fmt.Sprintf("%s: napi_status error: %s", err.Op, err.Status)
+	if err.Message != "" {
+		msg += ": " + err.Message
+	}
+
+	if err.EngineErrorCode != 0 {
+		msg += fmt.Sprintf(" (engine error code %d)", err.EngineErrorCode)
+	}
+
+	return msg
+}
+
+func (err *ExtendedError) Unwrap() error {
+	return StatusError(err.Status)
+}
+
+func (err *ExtendedError) Is(target error) bool {
+	st, ok := target.(StatusError)
+	return ok && Status(st) == err.Status
+}
+
+// GetLastErrorInfo returns the extended error information for the last
+// Node-API call made on env.
+func GetLastErrorInfo(env Env) (*ExtendedError, Status) {
+	var info *C.napi_extended_error_info
+	status := Status(C.napi_get_last_error_info(
+		C.napi_env(env),
+		&info,
+	))
+
+	if status != StatusOK || info == nil {
+		return nil, status
+	}
+
+	result := &ExtendedError{
+		Status:          Status(info.error_code),
+		EngineErrorCode: uint32(info.engine_error_code),
+	}
+
+	if info.error_message != nil {
+		result.Message = C.GoString(info.error_message)
+	}
+
+	return result, status
+}
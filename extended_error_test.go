@@ -0,0 +1,30 @@
+package napi
+
+import (
+	"testing"
+)
+
+func TestAsErrorFor(t *testing.T) {
+	addon := buildTestAddon(t, "extended_error")
+
+	tests := []struct {
+		fn   string
+		want string
+	}{
+		{
+			"getString",
+			"napi_get_value_string_utf8: napi_status error: napi_string_expected: A string was expected",
+		},
+		{
+			"getStringLate",
+			"napi_get_value_string_utf8: napi_status error: napi_string_expected",
+		},
+	}
+
+	for _, test := range tests {
+		script := "process.stdout.write(require(process.argv[1])." + test.fn + "(1))"
+		if got := runTestAddon(t, addon, script); got != test.want {
+			t.Errorf("%s: got %q, want %q", test.fn, got, test.want)
+		}
+	}
+}
@@ -9,9 +9,16 @@ extern void napiCallWrappedFinalizeFn(
 	void *finalize_data,
 	void *finalize_hint
 );
+
+extern void napiCallWrappedFinalizeHintFn(
+	napi_env env,
+	void *finalize_data,
+	void *finalize_hint
+);
 */
 import "C"
 import (
+	"runtime"
 	"runtime/cgo"
 	"unsafe"
 )
@@ -41,7 +48,42 @@ func napiCallWrappedFinalizeFn(
 	hintHandle.Delete()
 }
 
+// napiFinalizeHintFnData is used for finalizers where napi controls the
+// finalize_data pointer (e.g. external data), so the Go state travels through
+// the finalize_hint instead.
+type napiFinalizeHintFnData struct {
+	fn   FinalizeFn
+	data any
+	hint any
+
+	// pinner keeps Go memory handed to the engine in place until finalized.
+	pinner *runtime.Pinner
+}
+
+//export napiCallWrappedFinalizeHintFn
+func napiCallWrappedFinalizeHintFn(
+	env C.napi_env,
+	finalizeData, finalizeHint unsafe.Pointer,
+) {
+	hintHandle := cgo.Handle(finalizeHint)
+	hint := hintHandle.Value().(napiFinalizeHintFnData)
+	if hint.fn != nil {
+		hint.fn(
+			Env(env),
+			hint.data,
+			hint.hint,
+		)
+	}
+
+	if hint.pinner != nil {
+		hint.pinner.Unpin()
+	}
+
+	hintHandle.Delete()
+}
+
 var _cCallWrappedFinalizeFn = C.napiCallWrappedFinalizeFn
+var _cCallWrappedFinalizeHintFn = C.napiCallWrappedFinalizeHintFn
 
 func wrapFinalizeFnData(fn FinalizeFn, data any) unsafe.Pointer {
 	return unsafe.Pointer(cgo.NewHandle(napiFinalizeFnData{
@@ -49,3 +91,52 @@ func wrapFinalizeFnData(fn FinalizeFn, data any) unsafe.Pointer {
 		data: data,
 	}))
 }
+
+func wrapFinalizeFnHint(fn FinalizeFn, data any, hint any) unsafe.Pointer {
+	return unsafe.Pointer(cgo.NewHandle(napiFinalizeHintFnData{
+		fn:   fn,
+		data: data,
+		hint: hint,
+	}))
+}
+
+// wrapPinnedFinalizeFnHint is like wrapFinalizeFnHint, but also pins the Go
+// memory at ptr until the finalizer runs, so the engine may keep using it
+// after the call returns.
+func wrapPinnedFinalizeFnHint(
+	fn FinalizeFn,
+	data any,
+	hint any,
+	ptr unsafe.Pointer,
+) unsafe.Pointer {
+	pinner := new(runtime.Pinner)
+	if ptr != nil {
+		pinner.Pin(ptr)
+	}
+
+	return unsafe.Pointer(cgo.NewHandle(napiFinalizeHintFnData{
+		fn:     fn,
+		data:   data,
+		hint:   hint,
+		pinner: pinner,
+	}))
+}
+
+// finalizeFnHintData returns the data registered through wrapFinalizeFnHint,
+// without releasing it.
+func finalizeFnHintData(hint unsafe.Pointer) any {
+	return cgo.Handle(hint).Value().(napiFinalizeHintFnData).data
+}
+
+// deleteFinalizeFnHint releases hint without invoking its finalizer, returning
+// the data registered through wrapFinalizeFnHint.
+func deleteFinalizeFnHint(hint unsafe.Pointer) any {
+	hintHandle := cgo.Handle(hint)
+	hintData := hintHandle.Value().(napiFinalizeHintFnData)
+	if hintData.pinner != nil {
+		hintData.pinner.Unpin()
+	}
+
+	hintHandle.Delete()
+	return hintData.data
+}
@@ -0,0 +1,61 @@
+package napi
+
+/*
+#include <node/node_api.h>
+*/
+import "C"
+
+import "unsafe"
+
+type HandleScope unsafe.Pointer
+
+type EscapableHandleScope unsafe.Pointer
+
+func OpenHandleScope(env Env) (HandleScope, Status) {
+	var result HandleScope
+	status := Status(C.napi_open_handle_scope(
+		C.napi_env(env),
+		(*C.napi_handle_scope)(unsafe.Pointer(&result)),
+	))
+	return result, status
+}
+
+func CloseHandleScope(env Env, scope HandleScope) Status {
+	return Status(C.napi_close_handle_scope(
+		C.napi_env(env),
+		C.napi_handle_scope(scope),
+	))
+}
+
+func OpenEscapableHandleScope(env Env) (EscapableHandleScope, Status) {
+	var result EscapableHandleScope
+	status := Status(C.napi_open_escapable_handle_scope(
+		C.napi_env(env),
+		(*C.napi_escapable_handle_scope)(unsafe.Pointer(&result)),
+	))
+	return result, status
+}
+
+func CloseEscapableHandleScope(env Env, scope EscapableHandleScope) Status {
+	return Status(C.napi_close_escapable_handle_scope(
+		C.napi_env(env),
+		C.napi_escapable_handle_scope(scope),
+	))
+}
+
+// EscapeHandle promotes escapee to the scope enclosing scope, so it remains
+// valid once scope is closed. It can only be called once per scope.
+func EscapeHandle(
+	env Env,
+	scope EscapableHandleScope,
+	escapee Value,
+) (Value, Status) {
+	var result Value
+	status := Status(C.napi_escape_handle(
+		C.napi_env(env),
+		C.napi_escapable_handle_scope(scope),
+		C.napi_value(escapee),
+		(*C.napi_value)(unsafe.Pointer(&result)),
+	))
+	return result, status
+}
@@ -16,11 +16,27 @@ extern void DeleteCallbackData(
 	void *finalize_hint
 );
 
+extern void DeleteCallbackDataGroup(
+	napi_env env,
+	void *finalize_data,
+	void *finalize_hint
+);
+
 extern napi_value ExecuteCallback(
 	napi_env env,
 	napi_callback_info info
 );
 
+extern napi_value ExecuteGetterCallback(
+	napi_env env,
+	napi_callback_info info
+);
+
+extern napi_value ExecuteSetterCallback(
+	napi_env env,
+	napi_callback_info info
+);
+
 extern void ExecuteAsyncExecuteCallback(
 	napi_env env,
 	void *data
@@ -46,6 +62,7 @@ type NapiGoInstanceData struct {
 	UserData      any
 	CallbackData  NapiGoInstanceCallbackData
 	AsyncWorkData NapiGoInstanceAsyncWorkData
+	KeyedData     map[any]any
 }
 
 type NapiGoInstanceCallbackData struct {
@@ -60,6 +77,8 @@ type NapiGoInstanceCallbackMap map[NapiGoCallbackID]*NapiGoCallbackMapEntry
 
 type NapiGoCallbackMapEntry struct {
 	Callback Callback
+	Getter   Callback
+	Setter   Callback
 	ID       NapiGoCallbackID
 }
 
@@ -83,12 +102,22 @@ type InstanceDataProvider interface {
 	GetUserData() any
 	SetUserData(userData any)
 
+	GetKeyedData(key any) any
+	SetKeyedData(key, data any)
+
 	GetCallbackData() CallbackDataProvider
 	GetAsyncWorkData() AsyncWorkDataProvider
 }
 
 type CallbackDataProvider interface {
 	CreateCallback(env Env, name string, cb Callback) (Value, Status)
+	DefineProperties(env Env, object Value, properties []PropertyDescriptor) Status
+	DefineClass(
+		env Env,
+		name string,
+		constructor Callback,
+		properties []PropertyDescriptor,
+	) (Value, Status)
 	GetCallback(id NapiGoCallbackID) *NapiGoCallbackMapEntry
 	DeleteCallback(id NapiGoCallbackID)
 }
@@ -141,7 +170,7 @@ func DeleteCallbackData(
 			if err, ok := err.(error); ok {
 				msg = err.Error()
 			}
-			ThrowError(env, "", msg)
+			ThrowError(env, ErrCodePanic, msg)
 		}
 	}()
 
@@ -154,23 +183,97 @@ func DeleteCallbackData(
 	instanceData.GetCallbackData().DeleteCallback(id)
 }
 
+//export DeleteCallbackDataGroup
+func DeleteCallbackDataGroup(
+	cEnv C.napi_env,
+	finalizeData, finalizeHint unsafe.Pointer,
+) {
+	env := Env(cEnv)
+	idsHandle := cgo.Handle(finalizeData)
+	defer idsHandle.Delete()
+
+	defer func() {
+		err := recover()
+		if err != nil {
+			fmt.Printf("napi.DeleteCallbackDataGroup: Recovered from panic: %s\n", err)
+			reportStackTrace()
+
+			msg := "unknown error"
+			if err, ok := err.(error); ok {
+				msg = err.Error()
+			}
+			ThrowError(env, ErrCodePanic, msg)
+		}
+	}()
+
+	instanceData, status := getInstanceData(env)
+	if status != StatusOK {
+		panic(StatusError(status))
+	}
+
+	callbackData := instanceData.GetCallbackData()
+	for _, id := range idsHandle.Value().([]NapiGoCallbackID) {
+		callbackData.DeleteCallback(id)
+	}
+}
+
 //export ExecuteCallback
 func ExecuteCallback(
 	cEnv C.napi_env,
 	cInfo C.napi_callback_info,
+) C.napi_value {
+	return executeCallback(
+		"napi.ExecuteCallback",
+		cEnv,
+		cInfo,
+		func(entry *NapiGoCallbackMapEntry) Callback { return entry.Callback },
+	)
+}
+
+//export ExecuteGetterCallback
+func ExecuteGetterCallback(
+	cEnv C.napi_env,
+	cInfo C.napi_callback_info,
+) C.napi_value {
+	return executeCallback(
+		"napi.ExecuteGetterCallback",
+		cEnv,
+		cInfo,
+		func(entry *NapiGoCallbackMapEntry) Callback { return entry.Getter },
+	)
+}
+
+//export ExecuteSetterCallback
+func ExecuteSetterCallback(
+	cEnv C.napi_env,
+	cInfo C.napi_callback_info,
+) C.napi_value {
+	return executeCallback(
+		"napi.ExecuteSetterCallback",
+		cEnv,
+		cInfo,
+		func(entry *NapiGoCallbackMapEntry) Callback { return entry.Setter },
+	)
+}
+
+func executeCallback(
+	name string,
+	cEnv C.napi_env,
+	cInfo C.napi_callback_info,
+	selectCallback func(entry *NapiGoCallbackMapEntry) Callback,
 ) C.napi_value {
 	env := Env(cEnv)
 	defer func() {
 		err := recover()
 		if err != nil {
-			fmt.Printf("napi.ExecuteCallback: Recovered from panic: %s\n", err)
+			fmt.Printf("%s: Recovered from panic: %s\n", name, err)
 			reportStackTrace()
 
 			msg := "unknown error"
 			if err, ok := err.(error); ok {
 				msg = err.Error()
 			}
-			ThrowError(env, "", msg)
+			ThrowError(env, ErrCodePanic, msg)
 		}
 	}()
 
@@ -196,9 +299,12 @@ func ExecuteCallback(
 
 	id := *(*NapiGoCallbackID)(cData)
 	callbackData := instanceData.GetCallbackData().GetCallback(id)
+	if callbackData == nil {
+		panic(fmt.Errorf("callback %d has been released", id))
+	}
 
 	info := CallbackInfo(cInfo)
-	result := callbackData.Callback(env, info)
+	result := selectCallback(callbackData)(env, info)
 	return C.napi_value(result)
 }
 
@@ -218,7 +324,7 @@ func ExecuteAsyncExecuteCallback(cEnv C.napi_env, cData unsafe.Pointer) {
 			if err, ok := err.(error); ok {
 				msg = err.Error()
 			}
-			ThrowError(env, "", msg)
+			ThrowError(env, ErrCodePanic, msg)
 		}
 	}()
 
@@ -252,7 +358,7 @@ func ExecuteAsyncCompleteCallback(
 			if err, ok := err.(error); ok {
 				msg = err.Error()
 			}
-			ThrowError(env, "", msg)
+			ThrowError(env, ErrCodePanic, msg)
 		}
 	}()
 
@@ -324,6 +430,18 @@ func (d *NapiGoInstanceData) SetUserData(userData any) {
 	d.UserData = userData
 }
 
+func (d *NapiGoInstanceData) GetKeyedData(key any) any {
+	return d.KeyedData[key]
+}
+
+func (d *NapiGoInstanceData) SetKeyedData(key, data any) {
+	if d.KeyedData == nil {
+		d.KeyedData = make(map[any]any)
+	}
+
+	d.KeyedData[key] = data
+}
+
 func (d *NapiGoInstanceData) GetCallbackData() CallbackDataProvider {
 	return &d.CallbackData
 }
@@ -369,6 +487,181 @@ func (d *NapiGoInstanceCallbackData) CreateCallback(
 	return result, status
 }
 
+func (d *NapiGoInstanceCallbackData) DefineProperties(
+	env Env,
+	object Value,
+	properties []PropertyDescriptor,
+) Status {
+	// defining properties may run JS, e.g. a Proxy trap, which may call back
+	// into Go, so the lock must not be held while calling into napi
+	d.Lock.Lock()
+	cProperties, entries, free := d.newPropertyDescriptors(properties)
+	d.Lock.Unlock()
+	defer free()
+
+	status := Status(C.napi_define_properties(
+		C.napi_env(env),
+		C.napi_value(object),
+		C.size_t(len(properties)),
+		cProperties,
+	))
+
+	// properties defined before a failure still use their entries, so the
+	// entries are tied to object either way
+	if finalizerStatus := d.addEntryFinalizer(env, object, entries); finalizerStatus != StatusOK {
+		d.remove(entries)
+		if status == StatusOK {
+			status = finalizerStatus
+		}
+	}
+
+	return status
+}
+
+func (d *NapiGoInstanceCallbackData) DefineClass(
+	env Env,
+	name string,
+	constructor Callback,
+	properties []PropertyDescriptor,
+) (Value, Status) {
+	cname := C.CString(name)
+	defer C.free(unsafe.Pointer(cname))
+
+	// like DefineProperties, the lock must not be held while calling into napi
+	d.Lock.Lock()
+	constructorState := d.insert(constructor)
+	cProperties, entries, free := d.newPropertyDescriptors(properties)
+	d.Lock.Unlock()
+	defer free()
+
+	entries = append(entries, constructorState)
+
+	var result Value
+	status := Status(C.napi_define_class(
+		C.napi_env(env),
+		cname,
+		C.size_t(len([]byte(name))),
+		C.napi_callback(C.ExecuteCallback),
+		unsafe.Pointer(&constructorState.ID),
+		C.size_t(len(properties)),
+		cProperties,
+		(*C.napi_value)(unsafe.Pointer(&result)),
+	))
+
+	if status != StatusOK {
+		// no class was created, so none of the entries are in use
+		d.remove(entries)
+		return nil, status
+	}
+
+	// instances keep the constructor alive through their prototype, so the
+	// constructor owns the entries for both static and instance properties
+	status = d.addEntryFinalizer(env, result, entries)
+	if status != StatusOK {
+		d.remove(entries)
+		return nil, status
+	}
+
+	return result, status
+}
+
+// newPropertyDescriptors converts properties into a C array of
+// napi_property_descriptor, registering an entry for each property that uses
+// Go callbacks. Callers are expected to lock, and to call free once napi is
+// done with the descriptors.
+func (d *NapiGoInstanceCallbackData) newPropertyDescriptors(
+	properties []PropertyDescriptor,
+) (*C.napi_property_descriptor, []*NapiGoCallbackMapEntry, func()) {
+	if len(properties) == 0 {
+		return nil, nil, func() {}
+	}
+
+	// descriptors hold pointers, so they must live in C memory
+	cProperties := (*C.napi_property_descriptor)(C.calloc(
+		C.size_t(len(properties)),
+		C.sizeof_napi_property_descriptor,
+	))
+	cPropertySlice := unsafe.Slice(cProperties, len(properties))
+
+	var entries []*NapiGoCallbackMapEntry
+	var cNames []*C.char
+	for i, property := range properties {
+		cProperty := &cPropertySlice[i]
+		if property.Name != nil {
+			cProperty.name = C.napi_value(property.Name)
+		} else {
+			cName := C.CString(property.Utf8Name)
+			cNames = append(cNames, cName)
+			cProperty.utf8name = cName
+		}
+
+		cProperty.value = C.napi_value(property.Value)
+		cProperty.attributes = C.napi_property_attributes(property.Attributes)
+
+		if property.Method == nil && property.Getter == nil && property.Setter == nil {
+			continue
+		}
+
+		entry := d.insert(property.Method)
+		entry.Getter = property.Getter
+		entry.Setter = property.Setter
+		entries = append(entries, entry)
+
+		cProperty.data = unsafe.Pointer(&entry.ID)
+		if property.Method != nil {
+			cProperty.method = C.napi_callback(C.ExecuteCallback)
+		}
+		if property.Getter != nil {
+			cProperty.getter = C.napi_callback(C.ExecuteGetterCallback)
+		}
+		if property.Setter != nil {
+			cProperty.setter = C.napi_callback(C.ExecuteSetterCallback)
+		}
+	}
+
+	return cProperties, entries, func() {
+		for _, cName := range cNames {
+			C.free(unsafe.Pointer(cName))
+		}
+
+		C.free(unsafe.Pointer(cProperties))
+	}
+}
+
+// addEntryFinalizer ties the lifetime of entries to value, so they are
+// deleted once value is garbage collected. A single finalizer owns every
+// entry, so either all of them are tied to value or, on failure, none are.
+func (d *NapiGoInstanceCallbackData) addEntryFinalizer(
+	env Env,
+	value Value,
+	entries []*NapiGoCallbackMapEntry,
+) Status {
+	if len(entries) == 0 {
+		return StatusOK
+	}
+
+	ids := make([]NapiGoCallbackID, len(entries))
+	for i, entry := range entries {
+		ids[i] = entry.ID
+	}
+
+	idsHandle := cgo.NewHandle(ids)
+	status := Status(C.napi_add_finalizer(
+		C.napi_env(env),
+		C.napi_value(value),
+		unsafe.Pointer(idsHandle),
+		C.napi_finalize(C.DeleteCallbackDataGroup),
+		nil,
+		nil,
+	))
+
+	if status != StatusOK {
+		idsHandle.Delete()
+	}
+
+	return status
+}
+
 func (d *NapiGoInstanceCallbackData) GetCallback(
 	id NapiGoCallbackID,
 ) *NapiGoCallbackMapEntry {
@@ -383,6 +676,15 @@ func (d *NapiGoInstanceCallbackData) DeleteCallback(id NapiGoCallbackID) {
 	delete(d.CallbackMap, id)
 }
 
+func (d *NapiGoInstanceCallbackData) remove(entries []*NapiGoCallbackMapEntry) {
+	d.Lock.Lock()
+	defer d.Lock.Unlock()
+
+	for _, entry := range entries {
+		delete(d.CallbackMap, entry.ID)
+	}
+}
+
 func (d *NapiGoInstanceCallbackData) insert(
 	cb Callback,
 ) *NapiGoCallbackMapEntry {
@@ -0,0 +1,28 @@
+package napi
+
+import (
+	"testing"
+)
+
+// TestDefinePropertiesReentry defines a method on a Proxy whose trap calls back
+// into Go, which deadlocks if the callback lock is held while defining it.
+func TestDefinePropertiesReentry(t *testing.T) {
+	addon := buildTestAddon(t, "define_reentry")
+
+	const script = `
+const addon = require(process.argv[1]);
+const target = {};
+const proxy = new Proxy(target, {
+	defineProperty(target, key, descriptor) {
+		target.trapped = addon.ping();
+		return Reflect.defineProperty(target, key, descriptor);
+	},
+});
+addon.defineMethod(proxy);
+process.stdout.write(target.trapped + " " + target.ping());
+`
+
+	if got := runTestAddon(t, addon, script); got != "pong pong" {
+		t.Errorf("got %q, want %q", got, "pong pong")
+	}
+}
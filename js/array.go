@@ -0,0 +1,148 @@
+package js
+
+import (
+	"math"
+
+	"github.com/akshayganeshen/napi-go"
+)
+
+type Array struct {
+	Object
+}
+
+func (v Value) IsArray() (bool, error) {
+	b, st := napi.IsArray(v.Env.Env, v.Value)
+	if err := v.Env.statusError("napi_is_array", st); err != nil {
+		return false, err
+	}
+
+	return b, nil
+}
+
+func (v Value) AsArrayUnsafe() Array {
+	return Array{
+		Object: v.AsObjectUnsafe(),
+	}
+}
+
+func (v Value) AsArray() (Array, error) {
+	if ok, err := v.IsArray(); err != nil {
+		return Array{}, err
+	} else if !ok {
+		return Array{}, ErrWrongType
+	}
+
+	return v.AsArrayUnsafe(), nil
+}
+
+func (e Env) NewArray(length int) (Array, error) {
+	v, st := napi.CreateArrayWithLength(e.Env, length)
+	if err := e.statusError("napi_create_array_with_length", st); err != nil {
+		return Array{}, err
+	}
+
+	return e.WrapValue(v).AsArrayUnsafe(), nil
+}
+
+func (a Array) Len() (int, error) {
+	n, st := napi.GetArrayLength(a.Env.Env, a.Value.Value)
+	if err := a.Env.statusError("napi_get_array_length", st); err != nil {
+		return 0, err
+	}
+
+	return n, nil
+}
+
+func (a Array) Index(i int) (Value, error) {
+	return a.GetElement(i)
+}
+
+func (a Array) SetIndex(i int, value Value) error {
+	return a.SetElement(i, value)
+}
+
+func (a Array) Push(values ...Value) error {
+	n, err := a.Len()
+	if err != nil {
+		return err
+	}
+
+	for i, value := range values {
+		if err := a.SetIndex(n+i, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Slice returns the elements in the range [start, end) as Go values. Like
+// Array.prototype.slice, negative indices count back from the end of the
+// array, and the range is clamped to the bounds of the array.
+func (a Array) Slice(start, end int) ([]Value, error) {
+	n, err := a.Len()
+	if err != nil {
+		return nil, err
+	}
+
+	start = clampSliceIndex(start, n)
+	end = clampSliceIndex(end, n)
+	if end < start {
+		end = start
+	}
+
+	result := make([]Value, end-start)
+	for i := range result {
+		value, err := a.Index(start + i)
+		if err != nil {
+			return nil, err
+		}
+
+		result[i] = value
+	}
+
+	return result, nil
+}
+
+func (a Array) Values() ([]Value, error) {
+	return a.Slice(0, math.MaxInt)
+}
+
+// Range calls fn for each element of the array, stopping early if fn returns
+// false.
+func (a Array) Range(fn func(i int, value Value) bool) error {
+	n, err := a.Len()
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < n; i++ {
+		value, err := a.Index(i)
+		if err != nil {
+			return err
+		}
+
+		if !fn(i, value) {
+			break
+		}
+	}
+
+	return nil
+}
+
+// clampSliceIndex resolves i like Array.prototype.slice does for an array of
+// length n.
+func clampSliceIndex(i, n int) int {
+	if i < 0 {
+		i += n
+		if i < 0 {
+			return 0
+		}
+	}
+
+	if i > n {
+		return n
+	}
+
+	return i
+}
@@ -0,0 +1,28 @@
+package js
+
+import (
+	"testing"
+)
+
+func TestClampSliceIndex(t *testing.T) {
+	tests := []struct {
+		i, n int
+		want int
+	}{
+		{0, 3, 0},
+		{2, 3, 2},
+		{3, 3, 3},
+		{4, 3, 3},
+		{-1, 3, 2},
+		{-3, 3, 0},
+		{-4, 3, 0},
+		{0, 0, 0},
+		{-1, 0, 0},
+	}
+
+	for _, test := range tests {
+		if got := clampSliceIndex(test.i, test.n); got != test.want {
+			t.Errorf("clampSliceIndex(%d, %d) = %d; want %d", test.i, test.n, got, test.want)
+		}
+	}
+}
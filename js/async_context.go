@@ -0,0 +1,119 @@
+package js
+
+import (
+	"errors"
+
+	"github.com/akshayganeshen/napi-go"
+)
+
+// AsyncContext is the async context of the JS code that created it, e.g. a
+// request handler that starts work in Go. Calling back into JS through the
+// AsyncContext restores that context, so AsyncLocalStorage and async_hooks
+// treat the callback as a continuation of the code that created it.
+type AsyncContext struct {
+	Env      Env
+	Context  napi.AsyncContext
+	resource Ref
+}
+
+// NewAsyncContext captures the current async context, naming it for
+// async_hooks. Destroy must be called once it is no longer needed.
+func (e Env) NewAsyncContext(name string) (AsyncContext, error) {
+	resource, st := napi.CreateObject(e.Env)
+	if err := e.statusError("napi_create_object", st); err != nil {
+		return AsyncContext{}, err
+	}
+
+	nameValue, st := napi.CreateStringUtf8(e.Env, name)
+	if err := e.statusError("napi_create_string_utf8", st); err != nil {
+		return AsyncContext{}, err
+	}
+
+	resourceRef, err := e.WrapValue(resource).NewRef()
+	if err != nil {
+		return AsyncContext{}, err
+	}
+
+	asyncContext, st := napi.AsyncInit(e.Env, resource, nameValue)
+	if err := e.statusError("napi_async_init", st); err != nil {
+		resourceRef.Delete()
+		return AsyncContext{}, err
+	}
+
+	return AsyncContext{
+		Env:      e,
+		Context:  asyncContext,
+		resource: resourceRef,
+	}, nil
+}
+
+func (c AsyncContext) Valid() bool {
+	return c.Context != nil
+}
+
+// Resource returns the object that represents the async context in
+// async_hooks.
+func (c AsyncContext) Resource() (Object, error) {
+	v, err := c.resource.GetValue()
+	if err != nil {
+		return Object{}, err
+	}
+
+	return v.AsObjectUnsafe(), nil
+}
+
+// MakeCallback calls fn like Function.Call, within the async context.
+func (c AsyncContext) MakeCallback(fn Function, this any, args ...any) (Value, error) {
+	thisValue, err := c.Env.ValueOf(this)
+	if err != nil {
+		return Value{}, err
+	}
+
+	argValues := make([]napi.Value, len(args))
+	for i, arg := range args {
+		value, err := c.Env.ValueOf(arg)
+		if err != nil {
+			return Value{}, err
+		}
+
+		argValues[i] = value.Value
+	}
+
+	result, st := napi.MakeCallback(c.Env.Env, c.Context, thisValue.Value, fn.Value.Value, argValues)
+	if err := c.Env.callError("napi_make_callback", st); err != nil {
+		return Value{}, err
+	}
+
+	return c.Env.WrapValue(result), nil
+}
+
+// Run calls fn within the async context, so any JS it calls, e.g. with
+// Function.Call or by settling a promise, runs in that context.
+func (c AsyncContext) Run(fn func() error) (err error) {
+	resource, err := c.resource.GetValue()
+	if err != nil {
+		return err
+	}
+
+	scope, st := napi.OpenCallbackScope(c.Env.Env, resource.Value, c.Context)
+	if err := c.Env.statusError("napi_open_callback_scope", st); err != nil {
+		return err
+	}
+
+	defer func() {
+		st := napi.CloseCallbackScope(c.Env.Env, scope)
+		err = errors.Join(err, c.Env.statusError("napi_close_callback_scope", st))
+	}()
+
+	return fn()
+}
+
+// Destroy releases the async context, which must not be used afterwards.
+func (c AsyncContext) Destroy() error {
+	st := napi.AsyncDestroy(c.Env.Env, c.Context)
+	if err := c.Env.statusError("napi_async_destroy", st); err != nil {
+		return err
+	}
+
+	return c.resource.Delete()
+}
@@ -0,0 +1,147 @@
+package js
+
+import (
+	"encoding/binary"
+	"math"
+	"math/big"
+
+	"github.com/akshayganeshen/napi-go"
+)
+
+// IntegerPolicy controls how Env.ValueOf converts Go integers that do not fit
+// in a JS number without losing precision.
+type IntegerPolicy int
+
+const (
+	// IntegerPolicyNumber converts every integer to a JS number, silently
+	// rounding values beyond Number.MAX_SAFE_INTEGER.
+	IntegerPolicyNumber IntegerPolicy = iota
+
+	// IntegerPolicyBigint converts int, int64, uint and uint64 values beyond
+	// Number.MAX_SAFE_INTEGER to a JS BigInt, and all others to a JS number.
+	IntegerPolicyBigint
+)
+
+type integerPolicyKey struct{}
+
+const maxSafeInteger = 1<<53 - 1
+
+// IntegerPolicy returns the IntegerPolicy used by ValueOf in e, which is
+// IntegerPolicyNumber unless set with SetIntegerPolicy.
+func (e Env) IntegerPolicy() IntegerPolicy {
+	data, st := napi.GetKeyedInstanceData(e.Env, integerPolicyKey{})
+	if st != napi.StatusOK {
+		return IntegerPolicyNumber
+	}
+
+	policy, _ := data.(IntegerPolicy)
+	return policy
+}
+
+// SetIntegerPolicy sets the IntegerPolicy used by ValueOf in e. The policy is
+// kept per env, so each worker thread sets its own.
+func (e Env) SetIntegerPolicy(policy IntegerPolicy) error {
+	return e.statusError("napi_set_instance_data", napi.SetKeyedInstanceData(e.Env, integerPolicyKey{}, policy))
+}
+
+func (e Env) NewBigInt(x *big.Int) (Value, error) {
+	negative, words := bigIntToWords(x)
+	v, st := napi.CreateBigintWords(e.Env, negative, words)
+	if err := e.statusError("napi_create_bigint_words", st); err != nil {
+		return Value{}, err
+	}
+
+	return e.WrapValue(v), nil
+}
+
+func (v Value) AsBigInt() (*big.Int, error) {
+	if ok, err := v.IsNumber(); err != nil {
+		return nil, err
+	} else if ok {
+		f, st := napi.GetValueDouble(v.Env.Env, v.Value)
+		if err := v.Env.statusError("napi_get_value_double", st); err != nil {
+			return nil, err
+		}
+
+		if math.IsInf(f, 0) || math.IsNaN(f) || f != math.Trunc(f) {
+			return nil, ErrWrongType
+		}
+
+		n, _ := big.NewFloat(f).Int(nil)
+		return n, nil
+	}
+
+	if ok, err := v.IsBigint(); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, ErrWrongType
+	}
+
+	negative, words, st := napi.GetValueBigintWords(v.Env.Env, v.Value)
+	if err := v.Env.statusError("napi_get_value_bigint_words", st); err != nil {
+		return nil, err
+	}
+
+	return wordsToBigInt(negative, words), nil
+}
+
+func (e Env) valueOfInt64(x int64) (Value, error) {
+	if (x > maxSafeInteger || x < -maxSafeInteger) &&
+		e.IntegerPolicy() == IntegerPolicyBigint {
+		v, st := napi.CreateBigintInt64(e.Env, x)
+		if err := e.statusError("napi_create_bigint_int64", st); err != nil {
+			return Value{}, err
+		}
+
+		return e.WrapValue(v), nil
+	}
+
+	return e.ValueOf(float64(x))
+}
+
+func (e Env) valueOfUint64(x uint64) (Value, error) {
+	if x > maxSafeInteger && e.IntegerPolicy() == IntegerPolicyBigint {
+		v, st := napi.CreateBigintUint64(e.Env, x)
+		if err := e.statusError("napi_create_bigint_uint64", st); err != nil {
+			return Value{}, err
+		}
+
+		return e.WrapValue(v), nil
+	}
+
+	return e.ValueOf(float64(x))
+}
+
+func bigIntToWords(x *big.Int) (bool, []uint64) {
+	// big.Word is platform dependent, so go through the byte representation
+	// to always produce 64-bit words
+	b := x.Bytes()
+	words := make([]uint64, (len(b)+7)/8)
+	for i := range words {
+		var word [8]byte
+		end := len(b) - i*8
+		start := end - 8
+		if start < 0 {
+			start = 0
+		}
+
+		copy(word[8-(end-start):], b[start:end])
+		words[i] = binary.BigEndian.Uint64(word[:])
+	}
+
+	return x.Sign() < 0, words
+}
+
+func wordsToBigInt(negative bool, words []uint64) *big.Int {
+	b := make([]byte, len(words)*8)
+	for i, word := range words {
+		binary.BigEndian.PutUint64(b[len(b)-(i+1)*8:], word)
+	}
+
+	n := new(big.Int).SetBytes(b)
+	if negative {
+		n.Neg(n)
+	}
+
+	return n
+}
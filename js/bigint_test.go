@@ -0,0 +1,49 @@
+package js
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+func TestBigIntWords(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		negative bool
+		words    []uint64
+	}{
+		{"zero", "0", false, []uint64{}},
+		{"one", "1", false, []uint64{1}},
+		{"negative one", "-1", true, []uint64{1}},
+		{"max uint64", "18446744073709551615", false, []uint64{0xffffffffffffffff}},
+		{"two words", "18446744073709551616", false, []uint64{0, 1}},
+		{"negative two words", "-36893488147419103231", true, []uint64{0xffffffffffffffff, 1}},
+		{"partial high word", "0x123456789abcdef0123", false, []uint64{0x456789abcdef0123, 0x123}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			x, ok := new(big.Int).SetString(test.value, 0)
+			if !ok {
+				t.Fatalf("invalid value: %s", test.value)
+			}
+
+			negative, words := bigIntToWords(x)
+			if negative != test.negative || !reflect.DeepEqual(words, test.words) {
+				t.Errorf("bigIntToWords(%s) = %v, %#x; want %v, %#x", x, negative, words, test.negative, test.words)
+			}
+
+			if got := wordsToBigInt(test.negative, test.words); got.Cmp(x) != 0 {
+				t.Errorf("wordsToBigInt(%v, %#x) = %s; want %s", test.negative, test.words, got, x)
+			}
+		})
+	}
+}
+
+func TestWordsToBigIntLeadingZeros(t *testing.T) {
+	got := wordsToBigInt(false, []uint64{42, 0, 0})
+	if got.Cmp(big.NewInt(42)) != 0 {
+		t.Errorf("wordsToBigInt(false, [42 0 0]) = %s; want 42", got)
+	}
+}
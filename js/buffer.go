@@ -8,7 +8,7 @@ type Buffer struct {
 
 func (v Value) IsBuffer() (bool, error) {
 	b, st := napi.IsBuffer(v.Env.Env, v.Value)
-	if err := st.AsError(); err != nil {
+	if err := v.Env.statusError("napi_is_buffer", st); err != nil {
 		return false, err
 	}
 
@@ -34,8 +34,62 @@ func (v Value) AsBuffer() (Buffer, error) {
 func (v Buffer) GetBytes() ([]byte, error) {
 	data, st := napi.GetBufferInfo(v.Env.Env, v.Value.Value)
 	if st != napi.StatusOK {
-		return nil, napi.StatusError(st)
+		return nil, v.Env.statusError("napi_get_buffer_info", st)
 	}
 
 	return data, nil
 }
+
+// NewBuffer creates a Buffer holding a copy of data.
+func (e Env) NewBuffer(data []byte) (Buffer, error) {
+	v, _, st := napi.CreateBufferCopy(e.Env, data)
+	if err := e.statusError("napi_create_buffer_copy", st); err != nil {
+		return Buffer{}, err
+	}
+
+	return e.WrapValue(v).AsBufferUnsafe(), nil
+}
+
+// NewExternalBuffer creates a Buffer backed by data without copying it. data
+// is pinned until the Buffer is garbage collected, and its size is reported
+// to the engine until then. Changes to data are visible from JS, and
+// vice versa.
+//
+// If the runtime does not allow external buffers, data is copied instead.
+func (e Env) NewExternalBuffer(data []byte) (Buffer, error) {
+	memory := &externalMemory{size: int64(len(data))}
+	v, st := napi.CreateExternalBuffer(e.Env, data, memory.finalize, nil)
+	if st == napi.StatusNoExternalBuffersAllowed {
+		return e.NewBuffer(data)
+	}
+
+	if err := e.statusError("napi_create_external_buffer", st); err != nil {
+		return Buffer{}, err
+	}
+
+	if err := memory.report(e); err != nil {
+		return Buffer{}, err
+	}
+
+	return e.WrapValue(v).AsBufferUnsafe(), nil
+}
+
+// ToString decodes the contents of the Buffer like buf.toString(encoding).
+func (v Buffer) ToString(encoding BufferEncoding) (string, error) {
+	data, err := v.GetBytes()
+	if err != nil {
+		return "", err
+	}
+
+	return EncodeBuffer(data, encoding)
+}
+
+// NewBufferFromString creates a Buffer like Buffer.from(str, encoding).
+func (e Env) NewBufferFromString(str string, encoding BufferEncoding) (Buffer, error) {
+	data, err := DecodeBuffer(str, encoding)
+	if err != nil {
+		return Buffer{}, err
+	}
+
+	return e.NewBuffer(data)
+}
@@ -0,0 +1,206 @@
+package js
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// BufferEncoding is a Node Buffer encoding, e.g. for buf.toString(encoding).
+type BufferEncoding string
+
+const (
+	BufferEncodingUTF8      BufferEncoding = "utf8"
+	BufferEncodingLatin1    BufferEncoding = "latin1"
+	BufferEncodingBase64    BufferEncoding = "base64"
+	BufferEncodingBase64URL BufferEncoding = "base64url"
+	BufferEncodingHex       BufferEncoding = "hex"
+)
+
+// EncodeBuffer converts data to a string like buf.toString(encoding) in Node,
+// e.g. invalid UTF-8 is replaced with U+FFFD, base64 is padded but base64url
+// is not, and hex is lower case.
+func EncodeBuffer(data []byte, encoding BufferEncoding) (string, error) {
+	switch normalizeBufferEncoding(encoding) {
+	case BufferEncodingUTF8:
+		return encodeUTF8(data), nil
+	case BufferEncodingLatin1:
+		runes := make([]rune, len(data))
+		for i, b := range data {
+			runes[i] = rune(b)
+		}
+
+		return string(runes), nil
+	case BufferEncodingBase64:
+		return base64.StdEncoding.EncodeToString(data), nil
+	case BufferEncodingBase64URL:
+		return base64.RawURLEncoding.EncodeToString(data), nil
+	case BufferEncodingHex:
+		return hex.EncodeToString(data), nil
+	}
+
+	return "", fmt.Errorf("unknown buffer encoding: %q", encoding)
+}
+
+// DecodeBuffer converts str to bytes like Buffer.from(str, encoding) in Node.
+// Like Node, decoding is lenient: base64 accepts either alphabet and ignores
+// padding and invalid characters, hex stops at the first invalid pair, and
+// latin1 keeps the lower 8 bits of each UTF-16 code unit.
+func DecodeBuffer(str string, encoding BufferEncoding) ([]byte, error) {
+	switch normalizeBufferEncoding(encoding) {
+	case BufferEncodingUTF8:
+		return []byte(str), nil
+	case BufferEncodingLatin1:
+		units := utf16.Encode([]rune(str))
+		data := make([]byte, len(units))
+		for i, unit := range units {
+			data[i] = byte(unit)
+		}
+
+		return data, nil
+	case BufferEncodingBase64, BufferEncodingBase64URL:
+		return decodeBase64(str), nil
+	case BufferEncodingHex:
+		return decodeHex(str), nil
+	}
+
+	return nil, fmt.Errorf("unknown buffer encoding: %q", encoding)
+}
+
+func normalizeBufferEncoding(encoding BufferEncoding) BufferEncoding {
+	switch strings.ToLower(string(encoding)) {
+	case "utf8", "utf-8", "":
+		return BufferEncodingUTF8
+	case "latin1", "binary":
+		return BufferEncodingLatin1
+	case "base64":
+		return BufferEncodingBase64
+	case "base64url":
+		return BufferEncodingBase64URL
+	case "hex":
+		return BufferEncodingHex
+	}
+
+	return encoding
+}
+
+// encodeUTF8 replaces each maximal invalid subsequence of data with U+FFFD,
+// following the WHATWG Encoding Standard like Node does. For example, a
+// truncated sequence becomes one replacement character, but an encoded
+// surrogate becomes one per byte.
+func encodeUTF8(data []byte) string {
+	if utf8.Valid(data) {
+		return string(data)
+	}
+
+	var b strings.Builder
+	b.Grow(len(data))
+
+	var codePoint rune
+	needed, seen := 0, 0
+	lower, upper := byte(0x80), byte(0xBF)
+	for i := 0; i < len(data); i++ {
+		c := data[i]
+		if needed == 0 {
+			switch {
+			case c <= 0x7F:
+				b.WriteByte(c)
+			case 0xC2 <= c && c <= 0xDF:
+				needed, codePoint = 1, rune(c&0x1F)
+			case 0xE0 <= c && c <= 0xEF:
+				if c == 0xE0 {
+					lower = 0xA0
+				} else if c == 0xED {
+					upper = 0x9F
+				}
+
+				needed, codePoint = 2, rune(c&0x0F)
+			case 0xF0 <= c && c <= 0xF4:
+				if c == 0xF0 {
+					lower = 0x90
+				} else if c == 0xF4 {
+					upper = 0x8F
+				}
+
+				needed, codePoint = 3, rune(c&0x07)
+			default:
+				b.WriteRune(utf8.RuneError)
+			}
+
+			continue
+		}
+
+		if c < lower || c > upper {
+			// the byte is not part of the sequence, so it starts the next one
+			needed, seen = 0, 0
+			lower, upper = 0x80, 0xBF
+			b.WriteRune(utf8.RuneError)
+			i--
+			continue
+		}
+
+		lower, upper = 0x80, 0xBF
+		codePoint = codePoint<<6 | rune(c&0x3F)
+		seen++
+		if seen == needed {
+			b.WriteRune(codePoint)
+			needed, seen = 0, 0
+		}
+	}
+
+	if needed != 0 {
+		b.WriteRune(utf8.RuneError)
+	}
+
+	return b.String()
+}
+
+func decodeBase64(str string) []byte {
+	var b strings.Builder
+	for _, r := range str {
+		switch {
+		case r == '=':
+			// padding ends the input
+			return decodeRawBase64(b.String())
+		case r == '-':
+			b.WriteByte('+')
+		case r == '_':
+			b.WriteByte('/')
+		case r == '+' || r == '/' ||
+			'A' <= r && r <= 'Z' ||
+			'a' <= r && r <= 'z' ||
+			'0' <= r && r <= '9':
+			b.WriteRune(r)
+		}
+	}
+
+	return decodeRawBase64(b.String())
+}
+
+func decodeRawBase64(str string) []byte {
+	// a single trailing character does not hold a full byte
+	if len(str)%4 == 1 {
+		str = str[:len(str)-1]
+	}
+
+	// str only holds valid characters, so this cannot fail
+	data, _ := base64.RawStdEncoding.DecodeString(str)
+	return data
+}
+
+func decodeHex(str string) []byte {
+	data := make([]byte, 0, len(str)/2)
+	for i := 0; i+1 < len(str); i += 2 {
+		b, err := hex.DecodeString(str[i : i+2])
+		if err != nil {
+			break
+		}
+
+		data = append(data, b[0])
+	}
+
+	return data
+}
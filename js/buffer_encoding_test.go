@@ -0,0 +1,96 @@
+package js
+
+import (
+	"bytes"
+	"testing"
+)
+
+// The expected values are the results of buf.toString(encoding) and
+// Buffer.from(str, encoding) in Node.
+
+func TestEncodeBuffer(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     []byte
+		encoding BufferEncoding
+		want     string
+	}{
+		{"utf8", []byte("héllo"), BufferEncodingUTF8, "héllo"},
+		{"utf8 default", []byte("hi"), "", "hi"},
+		{"utf8 alias", []byte("hi"), "UTF-8", "hi"},
+		{"utf8 invalid byte", []byte{0x68, 0xff, 0x69}, BufferEncodingUTF8, "h�i"},
+		{"utf8 invalid bytes", []byte{0xff, 0xff}, BufferEncodingUTF8, "��"},
+		{"utf8 truncated sequence", []byte{0xe2, 0x82}, BufferEncodingUTF8, "�"},
+		{"utf8 truncated sequence before ascii", []byte{0xe2, 0x82, 0x41}, BufferEncodingUTF8, "�A"},
+		{"utf8 surrogate", []byte{0xed, 0xa0, 0x80}, BufferEncodingUTF8, "���"},
+		{"utf8 overlong", []byte{0xc0, 0xaf}, BufferEncodingUTF8, "��"},
+		{"utf8 four bytes", []byte{0xf0, 0x9f, 0x98, 0x80}, BufferEncodingUTF8, "😀"},
+		{"latin1", []byte{0x68, 0xe9, 0xff}, BufferEncodingLatin1, "héÿ"},
+		{"binary", []byte{0xe9}, "binary", "é"},
+		{"base64", []byte("hi"), BufferEncodingBase64, "aGk="},
+		{"base64 url characters", []byte{0xfb, 0xff}, BufferEncodingBase64, "+/8="},
+		{"base64url", []byte{0xfb, 0xff}, BufferEncodingBase64URL, "-_8"},
+		{"hex", []byte{0x01, 0xab, 0xff}, BufferEncodingHex, "01abff"},
+		{"empty", nil, BufferEncodingHex, ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := EncodeBuffer(test.data, test.encoding)
+			if err != nil {
+				t.Fatalf("EncodeBuffer(%x, %q): unexpected error: %v", test.data, test.encoding, err)
+			}
+
+			if got != test.want {
+				t.Errorf("EncodeBuffer(%x, %q) = %q; want %q", test.data, test.encoding, got, test.want)
+			}
+		})
+	}
+}
+
+func TestDecodeBuffer(t *testing.T) {
+	tests := []struct {
+		name     string
+		str      string
+		encoding BufferEncoding
+		want     []byte
+	}{
+		{"utf8", "héllo", BufferEncodingUTF8, []byte("héllo")},
+		{"latin1", "hé", BufferEncodingLatin1, []byte{0x68, 0xe9}},
+		{"latin1 truncates code units", "€", BufferEncodingLatin1, []byte{0xac}},
+		{"latin1 surrogate pair", "😀", BufferEncodingLatin1, []byte{0x3d, 0x00}},
+		{"base64", "aGk=", BufferEncodingBase64, []byte("hi")},
+		{"base64 without padding", "aGk", BufferEncodingBase64, []byte("hi")},
+		{"base64 whitespace", "a G\nk=", BufferEncodingBase64, []byte("hi")},
+		{"base64 url alphabet", "-_8", BufferEncodingBase64, []byte{0xfb, 0xff}},
+		{"base64url standard alphabet", "+/8=", BufferEncodingBase64URL, []byte{0xfb, 0xff}},
+		{"base64 trailing character", "aGkxY", BufferEncodingBase64, []byte("hi1")},
+		{"hex", "01abFF", BufferEncodingHex, []byte{0x01, 0xab, 0xff}},
+		{"hex odd length", "abc", BufferEncodingHex, []byte{0xab}},
+		{"hex invalid pair", "01zz02", BufferEncodingHex, []byte{0x01}},
+		{"hex invalid start", "zz01", BufferEncodingHex, []byte{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := DecodeBuffer(test.str, test.encoding)
+			if err != nil {
+				t.Fatalf("DecodeBuffer(%q, %q): unexpected error: %v", test.str, test.encoding, err)
+			}
+
+			if !bytes.Equal(got, test.want) {
+				t.Errorf("DecodeBuffer(%q, %q) = %x; want %x", test.str, test.encoding, got, test.want)
+			}
+		})
+	}
+}
+
+func TestUnknownBufferEncoding(t *testing.T) {
+	if _, err := EncodeBuffer([]byte("hi"), "utf32"); err == nil {
+		t.Error("EncodeBuffer: expected an error for an unknown encoding")
+	}
+
+	if _, err := DecodeBuffer("hi", "utf32"); err == nil {
+		t.Error("DecodeBuffer: expected an error for an unknown encoding")
+	}
+}
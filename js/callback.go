@@ -3,6 +3,7 @@ package js
 import (
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/akshayganeshen/napi-go"
 )
@@ -12,12 +13,17 @@ var (
 
 	envType      = reflect.TypeOf(Env{})
 	valueType    = reflect.TypeOf(Value{})
+	valuesType   = reflect.TypeOf([]Value(nil))
 	stringType   = reflect.TypeOf("")
 	objectType   = reflect.TypeOf(Object{})
+	arrayType    = reflect.TypeOf(Array{})
 	bufferType   = reflect.TypeOf(Buffer{})
 	functionType = reflect.TypeOf(Function{})
 	promiseType  = reflect.TypeOf(Promise{})
 	errorType    = reflect.TypeOf(Error{})
+	dateType     = reflect.TypeOf(Date{})
+	timeType     = reflect.TypeOf(time.Time{})
+	byteType     = reflect.TypeOf(byte(0))
 )
 
 func MustCallback(fn any) napi.Callback {
@@ -29,11 +35,94 @@ func MustCallback(fn any) napi.Callback {
 	return cb
 }
 
+// Callback converts fn to a napi.Callback. fn takes an optional Env, then
+// 'this', then one parameter per JS argument, and returns nothing, a value, or
+// a value and an error, which is thrown if it is not nil.
+//
+// A variadic ...T parameter collects the remaining arguments, and so does a
+// trailing []Value parameter, e.g. func(this Value, args []Value). Any other
+// []T parameter takes a JS array and converts each of its elements to T. A
+// []byte parameter also takes a copy of the bytes of a Buffer, another
+// TypedArray, or an ArrayBuffer.
+//
+// A *T parameter takes an instance of the class for T, which must be created
+// with NewClass or registered with RegisterClass before fn is converted.
 func Callback(fn any) (napi.Callback, error) {
 	if cb, ok := fn.(napi.Callback); ok {
 		return cb, nil
 	}
 
+	invoke, err := reflectCallback(fn)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create the actual callback
+	return func(env napi.Env, info napi.CallbackInfo) napi.Value {
+		jsEnv := WrapEnv(env)
+
+		thisValue, args, ok := getCallbackArgs(jsEnv, info)
+		if !ok {
+			undef, _ := jsEnv.Undefined()
+			return undef.Value
+		}
+
+		results, ok := invoke(jsEnv, thisValue, args)
+		if !ok || len(results) == 0 {
+			undef, _ := jsEnv.Undefined()
+			return undef.Value
+		}
+
+		// Check for error (if two return values)
+		if len(results) == 2 {
+			if errVal := results[1]; !errVal.IsNil() {
+				jsEnv.ThrowGoError(errVal.Interface().(error))
+
+				undef, _ := jsEnv.Undefined()
+				return undef.Value
+			}
+		}
+
+		// Return the first result
+		result, err := jsEnv.ValueOf(results[0].Interface())
+		if err != nil {
+			napi.ThrowError(env, napi.ErrCodeResultType, err.Error())
+			undef, _ := jsEnv.Undefined()
+			return undef.Value
+		}
+
+		return result.Value
+	}, nil
+}
+
+// callbackInvoker converts JS arguments according to the signature of the
+// function it was created from, then calls it. If the arguments cannot be
+// converted, an error is thrown and ok is false.
+type callbackInvoker func(env Env, this Value, args []Value) (results []reflect.Value, ok bool)
+
+func getCallbackArgs(env Env, info napi.CallbackInfo) (Value, []Value, bool) {
+	cbInfo, st := napi.GetCbInfo(env.Env, info)
+	if st != napi.StatusOK {
+		napi.ThrowError(env.Env, napi.ErrCodeStatus, env.statusError("napi_get_cb_info", st).Error())
+		return Value{}, nil, false
+	}
+
+	thisValue := Value{
+		Env:   env,
+		Value: cbInfo.This,
+	}
+	args := make([]Value, len(cbInfo.Args))
+	for i, cbArg := range cbInfo.Args {
+		args[i] = Value{
+			Env:   env,
+			Value: cbArg,
+		}
+	}
+
+	return thisValue, args, true
+}
+
+func reflectCallback(fn any) (callbackInvoker, error) {
 	fnValue := reflect.ValueOf(fn)
 	fnType := fnValue.Type()
 
@@ -75,58 +164,32 @@ func Callback(fn any) (napi.Callback, error) {
 		return nil, fmt.Errorf("AsCallback: function must have a 'this' parameter")
 	}
 
+	if hasVariadic && paramIdx == numIn-1 {
+		return nil, fmt.Errorf("AsCallback: 'this' parameter cannot be variadic")
+	}
+
 	if err := validateCallbackArgType(fnType.In(paramIdx)); err != nil {
 		return nil, fmt.Errorf("AsCallback: 'this' parameter type %w", err)
 	}
 	paramIdx++
 
+	// a trailing []Value collects the remaining arguments, like ...Value
+	hasRest := !hasVariadic && paramIdx < numIn && fnType.In(numIn-1) == valuesType
+
 	// Validate remaining parameters
-	if paramIdx < numIn {
-		// Check if it's a single slice parameter
-		if numIn == paramIdx+1 && fnType.In(paramIdx).Kind() == reflect.Slice {
-			if err := validateCallbackArgType(fnType.In(paramIdx).Elem()); err != nil {
-				return nil, fmt.Errorf("AsCallback: slice element type %w", err)
-			}
-		} else {
-			// Multiple individual parameters
-			for i := paramIdx; i < numIn; i++ {
-				paramType := fnType.In(i)
-				if hasVariadic && i == numIn-1 {
-					// For variadic functions, check the slice element type
-					if paramType.Kind() != reflect.Slice {
-						panic(fmt.Sprintf("AsCallback: variadic parameter must be a slice, got %v", paramType))
-					}
-					paramType = paramType.Elem()
-				}
-				if err := validateCallbackArgType(paramType); err != nil {
-					return nil, fmt.Errorf("AsCallback: parameter %d %w", i, err)
-				}
-			}
+	for i := paramIdx; i < numIn; i++ {
+		paramType := fnType.In(i)
+		if hasVariadic && i == numIn-1 {
+			// For variadic functions, check the slice element type
+			paramType = paramType.Elem()
 		}
-	}
-
-	// Create the actual callback
-	return func(env napi.Env, info napi.CallbackInfo) napi.Value {
-		jsEnv := WrapEnv(env)
-
-		cbInfo, st := napi.GetCbInfo(env, info)
-		if st != napi.StatusOK {
-			napi.ThrowError(env, "", napi.StatusError(st).Error())
-			undef, _ := jsEnv.Undefined()
-			return undef.Value
+		if err := validateCallbackArgType(paramType); err != nil {
+			return nil, fmt.Errorf("AsCallback: parameter %d %w", i, err)
 		}
+	}
 
-		thisValue := Value{
-			Env:   jsEnv,
-			Value: cbInfo.This,
-		}
-		args := make([]Value, len(cbInfo.Args))
-		for i, cbArg := range cbInfo.Args {
-			args[i] = Value{
-				Env:   jsEnv,
-				Value: cbArg,
-			}
-		}
+	return func(jsEnv Env, thisValue Value, args []Value) ([]reflect.Value, bool) {
+		env := jsEnv.Env
 
 		// Build call arguments
 		callArgs := make([]reflect.Value, 0, numIn)
@@ -143,116 +206,96 @@ func Callback(fn any) (napi.Callback, error) {
 		thisType := fnType.In(paramIdx)
 		convertedThisArg, ok := convertCallbackArgType(thisValue, thisType)
 		if !ok {
-			napi.ThrowError(env, "", fmt.Sprintf("this: expected %v, got incompatible type: %s", thisType, thisValue.Type()))
-			undef, _ := jsEnv.Undefined()
-			return undef.Value
+			napi.ThrowTypeError(env, napi.ErrCodeArgType, fmt.Sprintf("this: expected %v, got incompatible type: %s", thisType, thisValue.Type()))
+			return nil, false
 		}
 		callArgs = append(callArgs, convertedThisArg)
 		paramIdx++
 
 		// Add remaining arguments
-		if paramIdx < numIn {
-			// Check if it's a single slice parameter
-			if numIn == paramIdx+1 && fnType.In(paramIdx).Kind() == reflect.Slice {
-				// Single slice parameter
-				sliceType := fnType.In(paramIdx)
-				elemType := sliceType.Elem()
-				slice := reflect.MakeSlice(sliceType, len(args), len(args))
-				for i, arg := range args {
-					convertedArg, ok := convertCallbackArgType(arg, elemType)
-					if !ok {
-						napi.ThrowError(env, "", fmt.Sprintf("Argument %d: expected %v, got incompatible type: %s", i, fnType.In(paramIdx), arg.Type()))
-						undef, _ := jsEnv.Undefined()
-						return undef.Value
-					}
-					slice.Index(i).Set(convertedArg)
-				}
-				callArgs = append(callArgs, slice)
-			} else {
-				// Multiple individual parameters
-				argsNeeded := numIn - paramIdx
-				if hasVariadic {
-					argsNeeded = numIn - paramIdx - 1
-				}
-
-				for i := 0; i < argsNeeded && paramIdx < numIn; i++ {
-					if i < len(args) {
-						convertedArg, ok := convertCallbackArgType(args[i], fnType.In(paramIdx))
-						if !ok {
-							napi.ThrowError(env, "", fmt.Sprintf("Argument %d: expected %v, got incompatible type: %s", i, fnType.In(paramIdx), args[i].Type()))
-							undef, _ := jsEnv.Undefined()
-							return undef.Value
-						}
-						callArgs = append(callArgs, convertedArg)
-					} else {
-						if hasVariadic {
-							napi.ThrowError(env, "", fmt.Sprintf("Expected at least %d argument(s), got %d", argsNeeded, len(args)))
-						} else {
-							napi.ThrowError(env, "", fmt.Sprintf("Expected %d argument(s), got %d", argsNeeded, len(args)))
-						}
-						undef, _ := jsEnv.Undefined()
-						return undef.Value
-					}
-					paramIdx++
-				}
+		argsNeeded := numIn - paramIdx
+		if hasVariadic || hasRest {
+			argsNeeded = numIn - paramIdx - 1
+		}
 
-				// Handle variadic arguments
-				if hasVariadic {
-					variadicType := fnType.In(numIn - 1).Elem()
-					remainingArgs := args[argsNeeded:]
-					for i, arg := range remainingArgs {
-						convertedArg, ok := convertCallbackArgType(arg, variadicType)
-						if !ok {
-							napi.ThrowError(env, "", fmt.Sprintf("Argument %d: expected %v, got incompatible type: %s", argsNeeded+i, variadicType, arg.Type()))
-							undef, _ := jsEnv.Undefined()
-							return undef.Value
-						}
-						callArgs = append(callArgs, convertedArg)
-					}
+		for i := 0; i < argsNeeded; i++ {
+			if i >= len(args) {
+				if hasVariadic || hasRest {
+					napi.ThrowTypeError(env, napi.ErrCodeArgCount, fmt.Sprintf("Expected at least %d argument(s), got %d", argsNeeded, len(args)))
+				} else {
+					napi.ThrowTypeError(env, napi.ErrCodeArgCount, fmt.Sprintf("Expected %d argument(s), got %d", argsNeeded, len(args)))
 				}
+				return nil, false
 			}
-		}
 
-		// Call the function
-		results := fnValue.Call(callArgs)
-		if len(results) == 0 {
-			undef, _ := jsEnv.Undefined()
-			return undef.Value
+			convertedArg, ok := convertCallbackArgType(args[i], fnType.In(paramIdx))
+			if !ok {
+				napi.ThrowTypeError(env, napi.ErrCodeArgType, fmt.Sprintf("Argument %d: expected %v, got incompatible type: %s", i, fnType.In(paramIdx), args[i].Type()))
+				return nil, false
+			}
+			callArgs = append(callArgs, convertedArg)
+			paramIdx++
 		}
 
-		// Check for error (if two return values)
-		if len(results) == 2 {
-			if errVal := results[1]; !errVal.IsNil() {
-				napi.ThrowError(env, "", errVal.Interface().(error).Error())
-				undef, _ := jsEnv.Undefined()
-				return undef.Value
+		// Handle variadic arguments
+		if hasVariadic {
+			variadicType := fnType.In(numIn - 1).Elem()
+			for i, arg := range args[argsNeeded:] {
+				convertedArg, ok := convertCallbackArgType(arg, variadicType)
+				if !ok {
+					napi.ThrowTypeError(env, napi.ErrCodeArgType, fmt.Sprintf("Argument %d: expected %v, got incompatible type: %s", argsNeeded+i, variadicType, arg.Type()))
+					return nil, false
+				}
+				callArgs = append(callArgs, convertedArg)
 			}
 		}
 
-		// Return the first result
-		result, err := jsEnv.ValueOf(results[0].Interface())
-		if err != nil {
-			napi.ThrowError(env, "", err.Error())
-			undef, _ := jsEnv.Undefined()
-			return undef.Value
+		// Handle rest arguments
+		if hasRest {
+			rest := make([]Value, len(args)-argsNeeded)
+			copy(rest, args[argsNeeded:])
+			callArgs = append(callArgs, reflect.ValueOf(rest))
 		}
 
-		return result.Value
+		return fnValue.Call(callArgs), true
 	}, nil
 }
 
 func validateCallbackArgType(targetType reflect.Type) error {
 	switch targetType {
 	default:
-		return fmt.Errorf("must be Value, string, Object, Buffer, Function, Promise, or Error but got %v", targetType)
+		switch targetType.Kind() {
+		case reflect.Slice:
+			// slices are converted element-wise from JS arrays
+			return validateCallbackArgType(targetType.Elem())
+
+		case reflect.Bool, reflect.String,
+			reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+			return nil
+
+		case reflect.Ptr:
+			// class instances are unwrapped from JS objects (see NewClass)
+			if isClassType(targetType) {
+				return nil
+			}
+
+			return fmt.Errorf("must be a pointer to a class type created with NewClass or registered with RegisterClass but got %v", targetType)
+		}
+
+		return fmt.Errorf("must be Value, string, bool, a number, Object, Array, Buffer, Function, Promise, Error, Date, time.Time, a class pointer, or a slice of these but got %v", targetType)
 
 	case valueType:
 	case stringType:
 	case objectType:
+	case arrayType:
 	case bufferType:
 	case functionType:
 	case promiseType:
 	case errorType:
+	case dateType:
+	case timeType:
 	}
 
 	return nil
@@ -260,6 +303,23 @@ func validateCallbackArgType(targetType reflect.Type) error {
 
 func convertCallbackArgType(val Value, targetType reflect.Type) (reflect.Value, bool) {
 	switch targetType {
+	default:
+		switch targetType.Kind() {
+		case reflect.Slice:
+			if targetType.Elem() == byteType {
+				if data, ok := convertCallbackBytesArgType(val, targetType); ok {
+					return data, true
+				}
+			}
+
+			return convertCallbackSliceArgType(val, targetType)
+
+		case reflect.Ptr:
+			return convertCallbackWrappedArgType(val, targetType)
+		}
+
+		return convertCallbackPrimitiveArgType(val, targetType)
+
 	case valueType:
 		return reflect.ValueOf(val), true
 
@@ -273,6 +333,11 @@ func convertCallbackArgType(val Value, targetType reflect.Type) (reflect.Value,
 			return reflect.ValueOf(obj), true
 		}
 
+	case arrayType:
+		if arr, err := val.AsArray(); err == nil {
+			return reflect.ValueOf(arr), true
+		}
+
 	case bufferType:
 		if buf, err := val.AsBuffer(); err == nil {
 			return reflect.ValueOf(buf), true
@@ -292,7 +357,131 @@ func convertCallbackArgType(val Value, targetType reflect.Type) (reflect.Value,
 		if fn, err := val.AsError(); err != nil {
 			return reflect.ValueOf(fn), true
 		}
+
+	case dateType:
+		if d, err := val.AsDate(); err == nil {
+			return reflect.ValueOf(d), true
+		}
+
+	case timeType:
+		if t, err := val.AsTime(); err == nil {
+			return reflect.ValueOf(t), true
+		}
 	}
 
 	return reflect.Value{}, false
 }
+
+// convertCallbackBytesArgType copies the bytes of a Buffer, another
+// TypedArray, or an ArrayBuffer.
+func convertCallbackBytesArgType(val Value, targetType reflect.Type) (reflect.Value, bool) {
+	env := val.Env.Env
+
+	var data []byte
+	if ok, st := napi.IsTypedarray(env, val.Value); st != napi.StatusOK {
+		return reflect.Value{}, false
+	} else if ok {
+		info, st := napi.GetTypedarrayInfo(env, val.Value)
+		if st != napi.StatusOK {
+			return reflect.Value{}, false
+		}
+
+		data = info.Data
+	} else if ok, st := napi.IsArraybuffer(env, val.Value); st != napi.StatusOK || !ok {
+		return reflect.Value{}, false
+	} else {
+		data, st = napi.GetArraybufferInfo(env, val.Value)
+		if st != napi.StatusOK {
+			return reflect.Value{}, false
+		}
+	}
+
+	// JS may modify or collect the memory once the callback returns
+	copied := make([]byte, len(data))
+	copy(copied, data)
+	return reflect.ValueOf(copied).Convert(targetType), true
+}
+
+func convertCallbackSliceArgType(val Value, targetType reflect.Type) (reflect.Value, bool) {
+	arr, err := val.AsArray()
+	if err != nil {
+		return reflect.Value{}, false
+	}
+
+	values, err := arr.Values()
+	if err != nil {
+		return reflect.Value{}, false
+	}
+
+	slice := reflect.MakeSlice(targetType, len(values), len(values))
+	for i, value := range values {
+		convertedValue, ok := convertCallbackArgType(value, targetType.Elem())
+		if !ok {
+			return reflect.Value{}, false
+		}
+
+		slice.Index(i).Set(convertedValue)
+	}
+
+	return slice, true
+}
+
+func convertCallbackPrimitiveArgType(val Value, targetType reflect.Type) (reflect.Value, bool) {
+	result := reflect.New(targetType).Elem()
+
+	switch targetType.Kind() {
+	case reflect.Bool:
+		b, err := val.AsBool()
+		if err != nil {
+			return reflect.Value{}, false
+		}
+
+		result.SetBool(b)
+
+	case reflect.String:
+		s, err := val.AsString()
+		if err != nil {
+			return reflect.Value{}, false
+		}
+
+		result.SetString(s)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := val.AsBigInt()
+		if err != nil || !n.IsInt64() || result.OverflowInt(n.Int64()) {
+			return reflect.Value{}, false
+		}
+
+		result.SetInt(n.Int64())
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := val.AsBigInt()
+		if err != nil || !n.IsUint64() || result.OverflowUint(n.Uint64()) {
+			return reflect.Value{}, false
+		}
+
+		result.SetUint(n.Uint64())
+
+	case reflect.Float32, reflect.Float64:
+		f, err := val.AsFloat64()
+		if err != nil {
+			return reflect.Value{}, false
+		}
+
+		result.SetFloat(f)
+
+	default:
+		return reflect.Value{}, false
+	}
+
+	return result, true
+}
+
+func convertCallbackWrappedArgType(val Value, targetType reflect.Type) (reflect.Value, bool) {
+	wrapped, err := unwrapTagged(val, targetType)
+	if err != nil || reflect.TypeOf(wrapped) != targetType {
+		return reflect.Value{}, false
+	}
+
+	return reflect.ValueOf(wrapped), true
+}
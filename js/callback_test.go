@@ -0,0 +1,70 @@
+package js
+
+import (
+	"testing"
+)
+
+func TestCallbackRestArguments(t *testing.T) {
+	args := []Value{{}, {}, {}}
+
+	tests := []struct {
+		name string
+		fn   func(got *int) any
+		want int
+	}{
+		{
+			"trailing []Value",
+			func(got *int) any {
+				return func(this Value, args []Value) {
+					*got = len(args)
+				}
+			},
+			3,
+		},
+		{
+			"trailing []Value after a parameter",
+			func(got *int) any {
+				return func(this Value, first Value, rest []Value) {
+					*got = len(rest)
+				}
+			},
+			2,
+		},
+		{
+			"trailing []Value with Env",
+			func(got *int) any {
+				return func(env Env, this Value, args []Value) {
+					*got = len(args)
+				}
+			},
+			3,
+		},
+		{
+			"variadic ...Value",
+			func(got *int) any {
+				return func(this Value, args ...Value) {
+					*got = len(args)
+				}
+			},
+			3,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := -1
+			invoke, err := reflectCallback(test.fn(&got))
+			if err != nil {
+				t.Fatalf("reflectCallback: unexpected error: %v", err)
+			}
+
+			if _, ok := invoke(Env{}, Value{}, args); !ok {
+				t.Fatal("invoke failed")
+			}
+
+			if got != test.want {
+				t.Errorf("got %d rest arguments; want %d", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,339 @@
+package js
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"unicode"
+
+	"github.com/akshayganeshen/napi-go"
+)
+
+// DefineClass defines a JS class, using constructor for its constructor
+// function. The constructor accepts any function supported by Callback, and is
+// invoked with the new instance as 'this'.
+func (e Env) DefineClass(
+	name string,
+	constructor any,
+	properties ...PropertyDescriptor,
+) (Function, error) {
+	cb, err := Callback(constructor)
+	if err != nil {
+		return Function{}, err
+	}
+
+	napiProperties := make([]napi.PropertyDescriptor, len(properties))
+	for i, property := range properties {
+		napiProperty, err := e.napiPropertyDescriptor(property)
+		if err != nil {
+			return Function{}, err
+		}
+
+		napiProperties[i] = napiProperty
+	}
+
+	v, st := napi.DefineClass(e.Env, name, cb, napiProperties)
+	if err := e.statusError("napi_define_class", st); err != nil {
+		return Function{}, err
+	}
+
+	return e.WrapValue(v).AsFunctionUnsafe(), nil
+}
+
+// Class is a JS class whose instances wrap a *T.
+//
+// Once the class is created, or T is registered with RegisterClass, a wrapped
+// *T is accepted wherever Callback accepts a parameter, including 'this', so
+// Go functions can take instances of the class directly.
+type Class[T any] struct {
+	Function
+}
+
+// classTypes holds the *T types wrapped by classes, which Callback accepts as
+// parameters.
+var classTypes sync.Map // map[reflect.Type]struct{}
+
+// RegisterClass declares that *T is wrapped by a class created with NewClass,
+// so Callback accepts *T parameters before the class is created, e.g. for
+// functions exported from init. NewClass registers T itself.
+func RegisterClass[T any]() {
+	classTypes.Store(reflect.TypeOf((*T)(nil)), struct{}{})
+}
+
+func isClassType(t reflect.Type) bool {
+	_, ok := classTypes.Load(t)
+	return ok
+}
+
+// NewClass defines a JS class named name for the struct type T.
+//
+// The constructor follows the same rules as Callback, and must return *T or
+// (*T, error). If constructor is nil, instances start as the zero value of T.
+//
+// The exported methods of *T become prototype methods, with the receiver
+// bound to 'this'. Their remaining parameters and results follow the rules of
+// Callback, and may start with an Env parameter.
+//
+// If *T implements ExternalMemorySizer, the size of each instance is reported
+// to the engine until the instance is garbage collected.
+//
+// The exported fields of T become accessor properties on the prototype. The
+// property name can be set with a `js:"name"` struct tag, and `js:"-"` skips
+// the field. Fields of a type that cannot be converted from JS are read-only.
+//
+// Go names are converted to lower camel case for JS, e.g. ParseURL becomes
+// parseURL and ID becomes id.
+func NewClass[T any](env Env, name string, constructor any) (Class[T], error) {
+	ptrType := reflect.TypeOf((*T)(nil))
+	structType := ptrType.Elem()
+	if structType.Kind() != reflect.Struct {
+		return Class[T]{}, fmt.Errorf("NewClass: expected struct type, got %v", structType)
+	}
+
+	// methods take *T as 'this'
+	RegisterClass[T]()
+
+	var invoke callbackInvoker
+	if constructor != nil {
+		constructorType := reflect.TypeOf(constructor)
+		if constructorType.Kind() != reflect.Func ||
+			constructorType.NumOut() == 0 ||
+			constructorType.Out(0) != ptrType {
+			return Class[T]{}, fmt.Errorf("NewClass: constructor must return %v or (%v, error), got %v", ptrType, ptrType, constructorType)
+		}
+
+		var err error
+		invoke, err = reflectCallback(constructor)
+		if err != nil {
+			return Class[T]{}, fmt.Errorf("NewClass: constructor: %w", err)
+		}
+	}
+
+	var properties []PropertyDescriptor
+	for i := 0; i < ptrType.NumMethod(); i++ {
+		method := ptrType.Method(i)
+		cb, err := Callback(classMethodFunc(method))
+		if err != nil {
+			return Class[T]{}, fmt.Errorf("NewClass: method %s: %w", method.Name, err)
+		}
+
+		properties = append(properties, PropertyDescriptor{
+			Name:         jsName(method.Name),
+			Method:       cb,
+			Writable:     true,
+			Configurable: true,
+		})
+	}
+
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		if !field.IsExported() || field.Anonymous {
+			continue
+		}
+
+		fieldName := jsName(field.Name)
+		if tag, ok := field.Tag.Lookup("js"); ok {
+			if tag == "-" {
+				continue
+			}
+
+			fieldName = tag
+		}
+
+		properties = append(properties, classFieldProperty[T](fieldName, field))
+	}
+
+	cls, err := env.DefineClass(
+		name,
+		classConstructor[T](name, invoke),
+		properties...,
+	)
+	if err != nil {
+		return Class[T]{}, err
+	}
+
+	return Class[T]{
+		Function: cls,
+	}, nil
+}
+
+// Unwrap returns the *T wrapped by an instance of the class.
+func (c Class[T]) Unwrap(v AnyValue) (*T, error) {
+	wrapped, err := unwrapTagged(v.GetValue(), reflect.TypeOf((*T)(nil)))
+	if err != nil {
+		return nil, err
+	}
+
+	instance, ok := wrapped.(*T)
+	if !ok {
+		return nil, ErrWrongType
+	}
+
+	return instance, nil
+}
+
+func classConstructor[T any](name string, invoke callbackInvoker) napi.Callback {
+	return func(env napi.Env, info napi.CallbackInfo) napi.Value {
+		jsEnv := WrapEnv(env)
+
+		newTarget, st := napi.GetNewTarget(env, info)
+		if st != napi.StatusOK {
+			napi.ThrowError(env, napi.ErrCodeStatus, jsEnv.statusError("napi_get_new_target", st).Error())
+			return nil
+		}
+
+		if newTarget == nil {
+			napi.ThrowTypeError(env, napi.ErrCodeConstructor, fmt.Sprintf("Class constructor %s cannot be invoked without 'new'", name))
+			return nil
+		}
+
+		thisValue, args, ok := getCallbackArgs(jsEnv, info)
+		if !ok {
+			return nil
+		}
+
+		instance := new(T)
+		if invoke != nil {
+			results, ok := invoke(jsEnv, thisValue, args)
+			if !ok {
+				return nil
+			}
+
+			if len(results) == 2 {
+				if errVal := results[1]; !errVal.IsNil() {
+					jsEnv.ThrowGoError(errVal.Interface().(error))
+					return nil
+				}
+			}
+
+			instance = results[0].Interface().(*T)
+			if instance == nil {
+				napi.ThrowError(env, napi.ErrCodeConstructor, fmt.Sprintf("%s: constructor returned nil", name))
+				return nil
+			}
+		}
+
+		if err := thisValue.AsObjectUnsafe().SetTypeTag(TypeTagOf(reflect.TypeOf(instance))); err != nil {
+			jsEnv.ThrowGoError(err)
+			return nil
+		}
+
+		memory := newExternalMemory(instance)
+		if st := napi.Wrap(env, thisValue.Value, instance, memory.finalize, nil); st != napi.StatusOK {
+			napi.ThrowError(env, napi.ErrCodeStatus, jsEnv.statusError("napi_wrap", st).Error())
+			return nil
+		}
+
+		if err := memory.report(jsEnv); err != nil {
+			jsEnv.ThrowGoError(err)
+			return nil
+		}
+
+		return thisValue.Value
+	}
+}
+
+// classMethodFunc adapts a method of *T to the parameter order expected by
+// Callback, i.e. with an optional Env followed by the receiver as 'this'.
+func classMethodFunc(method reflect.Method) any {
+	methodType := method.Type
+
+	in := make([]reflect.Type, methodType.NumIn())
+	for i := range in {
+		in[i] = methodType.In(i)
+	}
+
+	hasEnv := len(in) > 1 && in[1] == envType
+	if hasEnv {
+		in[0], in[1] = in[1], in[0]
+	}
+
+	out := make([]reflect.Type, methodType.NumOut())
+	for i := range out {
+		out[i] = methodType.Out(i)
+	}
+
+	fnType := reflect.FuncOf(in, out, methodType.IsVariadic())
+	return reflect.MakeFunc(fnType, func(args []reflect.Value) []reflect.Value {
+		if hasEnv {
+			args[0], args[1] = args[1], args[0]
+		}
+
+		if methodType.IsVariadic() {
+			return method.Func.CallSlice(args)
+		}
+
+		return method.Func.Call(args)
+	}).Interface()
+}
+
+func classFieldProperty[T any](
+	name string,
+	field reflect.StructField,
+) PropertyDescriptor {
+	property := PropertyDescriptor{
+		Name:         name,
+		Configurable: true,
+		Getter: func(this *T) any {
+			return reflect.ValueOf(this).Elem().FieldByIndex(field.Index).Interface()
+		},
+	}
+
+	if validateCallbackArgType(field.Type) == nil {
+		property.Setter = func(this *T, value Value) (any, error) {
+			converted, ok := convertCallbackArgType(value, field.Type)
+			if !ok {
+				return nil, argTypeError(fmt.Sprintf("%s: expected %v, got incompatible type: %s", name, field.Type, value.Type()))
+			}
+
+			reflect.ValueOf(this).Elem().FieldByIndex(field.Index).Set(converted)
+			return nil, nil
+		}
+	}
+
+	return property
+}
+
+// argTypeError is returned for arguments of the wrong type by generated
+// functions, such as field setters, and is thrown like Callback's own argument
+// errors.
+type argTypeError string
+
+func init() {
+	RegisterErrorType[argTypeError](ErrorMapping{
+		Class: ErrorClassTypeError,
+		Code:  napi.ErrCodeArgType,
+	})
+}
+
+func (err argTypeError) Error() string {
+	return string(err)
+}
+
+// jsName converts an exported Go name to lower camel case, keeping acronyms
+// intact, e.g. "Parse" becomes "parse" and "URLPath" becomes "urlPath".
+func jsName(name string) string {
+	runes := []rune(name)
+
+	n := 0
+	for n < len(runes) && unicode.IsUpper(runes[n]) {
+		n++
+	}
+
+	// the last upper case rune of an acronym starts the next word, unless it
+	// is followed by a plural "s", e.g. IDs
+	if n > 1 && n < len(runes) && unicode.IsLower(runes[n]) && !isPluralSuffix(runes[n:]) {
+		n--
+	}
+
+	for i := 0; i < n; i++ {
+		runes[i] = unicode.ToLower(runes[i])
+	}
+
+	return string(runes)
+}
+
+// isPluralSuffix reports whether runes start with an "s" that ends a word.
+func isPluralSuffix(runes []rune) bool {
+	return runes[0] == 's' && (len(runes) == 1 || !unicode.IsLower(runes[1]))
+}
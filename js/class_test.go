@@ -0,0 +1,57 @@
+package js
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestJSName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"Parse", "parse"},
+		{"X", "x"},
+		{"ID", "id"},
+		{"URL", "url"},
+		{"IDs", "ids"},
+		{"IDsByName", "idsByName"},
+		{"URLs", "urls"},
+		{"URLPath", "urlPath"},
+		{"URLString", "urlString"},
+		{"ParseURL", "parseURL"},
+		{"HTTPServer", "httpServer"},
+		{"GetID", "getID"},
+		{"V2", "v2"},
+		{"ABC1", "abc1"},
+		{"getID", "getID"},
+	}
+
+	for _, test := range tests {
+		if got := jsName(test.name); got != test.want {
+			t.Errorf("jsName(%q) = %q; want %q", test.name, got, test.want)
+		}
+	}
+}
+
+type registeredClass struct{}
+
+func TestValidateCallbackClassPointer(t *testing.T) {
+	RegisterClass[registeredClass]()
+
+	if err := validateCallbackArgType(reflect.TypeOf(&registeredClass{})); err != nil {
+		t.Errorf("registered class pointer: unexpected error: %v", err)
+	}
+
+	if err := validateCallbackArgType(reflect.TypeOf([]*registeredClass{})); err != nil {
+		t.Errorf("slice of registered class pointers: unexpected error: %v", err)
+	}
+
+	for _, v := range []any{&big.Int{}, &time.Time{}, new(int)} {
+		if err := validateCallbackArgType(reflect.TypeOf(v)); err == nil {
+			t.Errorf("%T: expected an error", v)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package js
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"github.com/akshayganeshen/napi-go"
+)
+
+var (
+	ErrInvalidDate = errors.New("invalid date")
+)
+
+type Date struct {
+	Value
+}
+
+func (v Value) IsDate() (bool, error) {
+	b, st := napi.IsDate(v.Env.Env, v.Value)
+	if err := v.Env.statusError("napi_is_date", st); err != nil {
+		return false, err
+	}
+
+	return b, nil
+}
+
+func (v Value) AsDateUnsafe() Date {
+	return Date{
+		Value: v,
+	}
+}
+
+func (v Value) AsDate() (Date, error) {
+	if ok, err := v.IsDate(); err != nil {
+		return Date{}, err
+	} else if !ok {
+		return Date{}, ErrWrongType
+	}
+
+	return v.AsDateUnsafe(), nil
+}
+
+func (v Value) AsTime() (time.Time, error) {
+	d, err := v.AsDate()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return d.Time()
+}
+
+func (e Env) NewDate(t time.Time) (Date, error) {
+	v, st := napi.CreateDate(e.Env, float64(t.UnixMilli()))
+	if err := e.statusError("napi_create_date", st); err != nil {
+		return Date{}, err
+	}
+
+	return Date{
+		Value: e.WrapValue(v),
+	}, nil
+}
+
+// Time returns the Date as a time.Time in the local time zone. JS dates have
+// millisecond precision, so any finer precision is lost when round-tripping.
+func (d Date) Time() (time.Time, error) {
+	ms, st := napi.GetDateValue(d.Env.Env, d.Value.Value)
+	if err := d.Env.statusError("napi_get_date_value", st); err != nil {
+		return time.Time{}, err
+	}
+
+	if math.IsNaN(ms) {
+		return time.Time{}, ErrInvalidDate
+	}
+
+	return time.UnixMilli(int64(ms)), nil
+}
@@ -2,7 +2,9 @@ package js
 
 import (
 	"fmt"
+	"math/big"
 	"reflect"
+	"time"
 	"unsafe"
 
 	"github.com/akshayganeshen/napi-go"
@@ -22,6 +24,13 @@ type InvalidValueTypeError struct {
 
 var _ error = InvalidValueTypeError{}
 
+// statusError converts st, returned by a call to op, into an error including
+// the extended error information for the call. It must be called right after
+// the call, before any other call into napi.
+func (e Env) statusError(op string, st napi.Status) error {
+	return st.AsErrorFor(e.Env, op)
+}
+
 func WrapEnv(env napi.Env) Env {
 	return Env{
 		Env: env,
@@ -30,7 +39,7 @@ func WrapEnv(env napi.Env) Env {
 
 func (e Env) GetGlobal() (Object, error) {
 	v, st := napi.GetGlobal(e.Env)
-	if err := st.AsError(); err != nil {
+	if err := e.statusError("napi_get_global", st); err != nil {
 		return Object{}, err
 	}
 
@@ -39,7 +48,7 @@ func (e Env) GetGlobal() (Object, error) {
 
 func (e Env) Null() (Value, error) {
 	v, st := napi.GetNull(e.Env)
-	if err := st.AsError(); err != nil {
+	if err := e.statusError("napi_get_null", st); err != nil {
 		return Value{}, err
 	}
 
@@ -48,7 +57,7 @@ func (e Env) Null() (Value, error) {
 
 func (e Env) Undefined() (Value, error) {
 	v, st := napi.GetUndefined(e.Env)
-	if err := st.AsError(); err != nil {
+	if err := e.statusError("napi_get_undefined", st); err != nil {
 		return Value{}, err
 	}
 
@@ -59,6 +68,7 @@ func (e Env) ValueOf(x any) (Value, error) {
 	var (
 		v  napi.Value
 		st napi.Status
+		op string
 	)
 
 	switch xt := x.(type) {
@@ -69,102 +79,124 @@ func (e Env) ValueOf(x any) (Value, error) {
 	case Value:
 		return xt, nil
 	case []Value:
-		l := len(xt)
-		v, st = napi.CreateArrayWithLength(e.Env, l)
-		if st != napi.StatusOK {
-			break
+		arr, err := e.NewArray(len(xt))
+		if err != nil {
+			return Value{}, err
 		}
 
 		for i, xti := range xt {
-			// TODO: Use Value.SetIndex helper
-			st = napi.SetElement(e.Env, v, i, xti.Value)
-			if st != napi.StatusOK {
-				break
+			if err := arr.SetIndex(i, xti); err != nil {
+				return Value{}, err
 			}
 		}
+
+		return arr.Value, nil
 	case Function:
 		return xt.Value, nil
 	case napi.Value:
 		v, st = xt, napi.StatusOK
 
 	case nil:
+		op = "napi_get_null"
 		v, st = napi.GetNull(e.Env)
 	case bool:
+		op = "napi_get_boolean"
 		v, st = napi.GetBoolean(e.Env, xt)
 	case int:
-		v, st = napi.CreateDouble(e.Env, float64(xt))
+		return e.valueOfInt64(int64(xt))
 	case int8:
-		v, st = napi.CreateDouble(e.Env, float64(xt))
+		return e.ValueOf(float64(xt))
 	case int16:
-		v, st = napi.CreateDouble(e.Env, float64(xt))
+		return e.ValueOf(float64(xt))
 	case int64:
-		v, st = napi.CreateDouble(e.Env, float64(xt))
+		return e.valueOfInt64(xt)
 	case uint:
-		v, st = napi.CreateDouble(e.Env, float64(xt))
+		return e.valueOfUint64(uint64(xt))
 	case uint8:
-		v, st = napi.CreateDouble(e.Env, float64(xt))
+		return e.ValueOf(float64(xt))
 	case uint16:
-		v, st = napi.CreateDouble(e.Env, float64(xt))
+		return e.ValueOf(float64(xt))
 	case uint64:
-		v, st = napi.CreateDouble(e.Env, float64(xt))
+		return e.valueOfUint64(xt)
 	case uintptr:
-		v, st = napi.CreateDouble(e.Env, float64(xt))
+		return e.ValueOf(float64(xt))
 	case unsafe.Pointer:
-		v, st = napi.CreateDouble(e.Env, float64(uintptr(xt)))
+		return e.ValueOf(float64(uintptr(xt)))
 	case float32:
-		v, st = napi.CreateDouble(e.Env, float64(xt))
+		return e.ValueOf(float64(xt))
 	case float64:
+		op = "napi_create_double"
 		v, st = napi.CreateDouble(e.Env, xt)
 	case string:
+		op = "napi_create_string_utf8"
 		v, st = napi.CreateStringUtf8(e.Env, xt)
-	case error:
-		jsErr, err := e.NewError("", xt.Error())
+	case []uint16:
+		op = "napi_create_string_utf16"
+		v, st = napi.CreateStringUtf16(e.Env, xt)
+	case []byte:
+		op = "napi_create_buffer_copy"
+		v, _, st = napi.CreateBufferCopy(e.Env, xt)
+	case *big.Int:
+		if xt == nil {
+			return e.ValueOf(nil)
+		}
+
+		return e.NewBigInt(xt)
+	case time.Time:
+		d, err := e.NewDate(xt)
 		if err != nil {
 			return Value{}, err
 		}
 
-		v = jsErr.Value.Value
+		return d.Value, nil
+	case error:
+		return e.newGoError(xt)
 	case []any:
-		l := len(xt)
-		v, st = napi.CreateArrayWithLength(e.Env, l)
-		if st != napi.StatusOK {
-			break
+		arr, err := e.NewArray(len(xt))
+		if err != nil {
+			return Value{}, err
 		}
 
-		for i, xti := range xt {
-			// TODO: Use Value.SetIndex helper
-			vti, err := e.ValueOf(xti)
+		err = e.withScopeBatches(len(xt), func(i int) error {
+			vti, err := e.ValueOf(xt[i])
 			if err != nil {
-				return Value{}, err
+				return err
 			}
 
-			st = napi.SetElement(e.Env, v, i, vti.Value)
-			if st != napi.StatusOK {
-				break
-			}
+			return arr.SetIndex(i, vti)
+		})
+		if err != nil {
+			return Value{}, err
 		}
 
+		return arr.Value, nil
+
 	case map[string]any:
 		obj, err := e.NewObject()
 		if err != nil {
 			return Value{}, err
 		}
 
-		for xtk, xtv := range xt {
-			// TODO: Use Value.Set helper
-			vtk, err := e.ValueOf(xtk)
+		keys := make([]string, 0, len(xt))
+		for xtk := range xt {
+			keys = append(keys, xtk)
+		}
+
+		err = e.withScopeBatches(len(keys), func(i int) error {
+			vtk, err := e.ValueOf(keys[i])
 			if err != nil {
-				return Value{}, err
+				return err
 			}
 
-			vtv, err := e.ValueOf(xtv)
+			vtv, err := e.ValueOf(xt[keys[i]])
 			if err != nil {
-				return Value{}, err
+				return err
 			}
 
-			if err := obj.Set(vtk, vtv); err != nil {
-				return Value{}, err
-			}
+			return obj.Set(vtk, vtv)
+		})
+		if err != nil {
+			return Value{}, err
 		}
 
 		v = obj.Value.Value
@@ -182,7 +214,7 @@ func (e Env) ValueOf(x any) (Value, error) {
 		return Value{}, InvalidValueTypeError{x}
 	}
 
-	if err := st.AsError(); err != nil {
+	if err := e.statusError(op, st); err != nil {
 		return Value{}, err
 	}
 
@@ -213,6 +245,14 @@ func (e Env) WellKnownSymbol(name string) (Value, error) {
 	return symbolObj.AsObjectUnsafe().Get(nameValue)
 }
 
+// AddCleanupHook registers fn to run when the env shuts down, e.g. when a
+// worker thread exits or the process is exiting. Hooks run in the reverse
+// order they were added, and must not call into JS.
+func (e Env) AddCleanupHook(fn func()) error {
+	_, st := napi.AddEnvCleanupHook(e.Env, fn)
+	return e.statusError("napi_add_env_cleanup_hook", st)
+}
+
 func (err InvalidValueTypeError) Error() string {
 	return fmt.Sprintf("Value cannot be represented in JS: %T", err.Value)
 }
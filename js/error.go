@@ -8,7 +8,7 @@ type Error struct {
 
 func (v Value) IsError() (bool, error) {
 	b, st := napi.IsError(v.Env.Env, v.Value)
-	if err := st.AsError(); err != nil {
+	if err := v.Env.statusError("napi_is_error", st); err != nil {
 		return false, err
 	}
 
@@ -32,6 +32,27 @@ func (v Value) AsError() (Error, error) {
 }
 
 func (e Env) NewError(code string, message string) (Error, error) {
+	return e.newError("napi_create_error", napi.CreateError, code, message)
+}
+
+func (e Env) NewTypeError(code string, message string) (Error, error) {
+	return e.newError("napi_create_type_error", napi.CreateTypeError, code, message)
+}
+
+func (e Env) NewRangeError(code string, message string) (Error, error) {
+	return e.newError("napi_create_range_error", napi.CreateRangeError, code, message)
+}
+
+func (e Env) NewSyntaxError(code string, message string) (Error, error) {
+	return e.newError("node_api_create_syntax_error", napi.CreateSyntaxError, code, message)
+}
+
+func (e Env) newError(
+	op string,
+	create func(env napi.Env, code, msg napi.Value) (napi.Value, napi.Status),
+	code string,
+	message string,
+) (Error, error) {
 	var codeValue napi.Value
 	if code != "" {
 		value, err := e.ValueOf(code)
@@ -47,8 +68,8 @@ func (e Env) NewError(code string, message string) (Error, error) {
 		return Error{}, err
 	}
 
-	v, st := napi.CreateError(e.Env, codeValue, msgValue.Value)
-	if err := st.AsError(); err != nil {
+	v, st := create(e.Env, codeValue, msgValue.Value)
+	if err := e.statusError(op, st); err != nil {
 		return Error{}, err
 	}
 
@@ -66,8 +87,42 @@ func (e Env) ThrowError(code string, message string) error {
 	return jsErr.Throw()
 }
 
+func (e Env) ThrowTypeError(code string, message string) error {
+	jsErr, err := e.NewTypeError(code, message)
+	if err != nil {
+		return err
+	}
+
+	return jsErr.Throw()
+}
+
+func (e Env) ThrowRangeError(code string, message string) error {
+	jsErr, err := e.NewRangeError(code, message)
+	if err != nil {
+		return err
+	}
+
+	return jsErr.Throw()
+}
+
+func (e Env) ThrowSyntaxError(code string, message string) error {
+	jsErr, err := e.NewSyntaxError(code, message)
+	if err != nil {
+		return err
+	}
+
+	return jsErr.Throw()
+}
+
+// GoError converts the JS error back to a Go error, including its cause
+// chain and the errors of an AggregateError, which can be inspected with
+// errors.Unwrap, errors.Is and errors.As.
+func (e Error) GoError() error {
+	return e.Env.newException(e.Value)
+}
+
 func (e Error) Throw() error {
-	return napi.Throw(e.Env.Env, e.Value.Value).AsError()
+	return e.Env.statusError("napi_throw", napi.Throw(e.Env.Env, e.Value.Value))
 }
 
 type ErrorRef struct {
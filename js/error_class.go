@@ -0,0 +1,123 @@
+package js
+
+import (
+	"fmt"
+
+	"github.com/akshayganeshen/napi-go"
+)
+
+// defineErrorClassScript evaluates to a function that defines a subclass of
+// Base named name, like class extends Error in JS. The computed property name
+// gives the class itself the right name, and name is also set on the
+// prototype so instances report it, e.g. in their stack.
+const defineErrorClassScript = `(function (name, Base) {
+	const cls = ({
+		[name]: class extends Base {},
+	})[name];
+
+	Object.defineProperty(cls.prototype, "name", {
+		value: name,
+		writable: true,
+		configurable: true,
+	});
+
+	return cls;
+})`
+
+type errorClassesKey struct{}
+
+// DefineErrorClass defines a JS error class named name, which extends base if
+// given, or Error otherwise. Like any subclass of Error, instances capture
+// their stack when they are constructed.
+//
+// Go errors are thrown as instances of the class when they match an
+// ErrorMapping with the same ClassName. The class is defined for this env
+// only, so it should be defined for each env, e.g. with entry.OnInit.
+func (e Env) DefineErrorClass(name string, base ...Function) (Function, error) {
+	if len(base) > 1 {
+		return Function{}, fmt.Errorf("DefineErrorClass: expected at most one base class, got %d", len(base))
+	}
+
+	var baseValue Value
+	if len(base) > 0 {
+		baseValue = base[0].Value
+	} else {
+		global, err := e.GetGlobal()
+		if err != nil {
+			return Function{}, err
+		}
+
+		baseValue, err = global.GetNamed("Error")
+		if err != nil {
+			return Function{}, err
+		}
+	}
+
+	defineErrorClass, err := e.RunScript(defineErrorClassScript)
+	if err != nil {
+		return Function{}, err
+	}
+
+	cls, err := defineErrorClass.AsFunctionUnsafe().Call(nil, name, baseValue)
+	if err != nil {
+		return Function{}, err
+	}
+
+	ref, err := cls.NewRef()
+	if err != nil {
+		return Function{}, err
+	}
+
+	classes, err := e.errorClasses()
+	if err != nil {
+		return Function{}, err
+	}
+
+	if previous, ok := classes[name]; ok {
+		previous.Delete()
+	}
+
+	classes[name] = ref
+	return cls.AsFunctionUnsafe(), nil
+}
+
+// DefinedErrorClass returns the error class named name defined with
+// DefineErrorClass, and whether there is one.
+func (e Env) DefinedErrorClass(name string) (Function, bool, error) {
+	classes, err := e.errorClasses()
+	if err != nil {
+		return Function{}, false, err
+	}
+
+	ref, ok := classes[name]
+	if !ok {
+		return Function{}, false, nil
+	}
+
+	cls, err := ref.GetValue()
+	if err != nil {
+		return Function{}, false, err
+	}
+
+	return cls.AsFunctionUnsafe(), true, nil
+}
+
+// errorClasses returns the error classes defined for the env, by name.
+func (e Env) errorClasses() (map[string]Ref, error) {
+	data, st := napi.GetKeyedInstanceData(e.Env, errorClassesKey{})
+	if err := e.statusError("napi_get_instance_data", st); err != nil {
+		return nil, err
+	}
+
+	if classes, ok := data.(map[string]Ref); ok {
+		return classes, nil
+	}
+
+	classes := make(map[string]Ref)
+	st = napi.SetKeyedInstanceData(e.Env, errorClassesKey{}, classes)
+	if err := e.statusError("napi_set_instance_data", st); err != nil {
+		return nil, err
+	}
+
+	return classes, nil
+}
@@ -0,0 +1,284 @@
+package js
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/akshayganeshen/napi-go"
+)
+
+// ErrorClass is the built-in JS error class a Go error is converted to.
+type ErrorClass int
+
+const (
+	ErrorClassError ErrorClass = iota
+	ErrorClassTypeError
+	ErrorClassRangeError
+	ErrorClassSyntaxError
+)
+
+// ErrorMapping describes the JS error that a Go error is converted to, e.g.
+// when it is returned from a function wrapped by Callback.
+type ErrorMapping struct {
+	Class ErrorClass
+
+	// ClassName is the name of an error class defined with
+	// Env.DefineErrorClass, which is used instead of Class if it is defined
+	// for the env.
+	ClassName string
+
+	// Code is set as the code property. If it is empty, the code is taken
+	// from a JSCoder in the error chain, or from napi.ErrorCodeOf.
+	Code string
+
+	// Properties are set on the JS error, after being converted with
+	// Env.ValueOf.
+	Properties map[string]any
+
+	// PropertiesOf returns additional properties for the matched error, i.e.
+	// the error passed to RegisterError or the E found by RegisterErrorType.
+	PropertiesOf func(err error) map[string]any
+}
+
+// JSCoder is implemented by Go errors that know their JS error code.
+type JSCoder interface {
+	JSCode() string
+}
+
+type errorMappingEntry struct {
+	// match returns the error in the chain of err that the mapping applies
+	// to, if any
+	match   func(err error) (error, bool)
+	mapping ErrorMapping
+}
+
+var (
+	errorMappingsLock sync.RWMutex
+	errorMappings     []errorMappingEntry
+)
+
+// RegisterError maps Go errors matching target with errors.Is to JS errors
+// described by mapping, e.g.
+//
+//	js.RegisterError(fs.ErrNotExist, js.ErrorMapping{Code: "ENOENT"})
+//
+// Mappings are tried in the order they are registered, and the first match
+// is used.
+func RegisterError(target error, mapping ErrorMapping) {
+	registerErrorMapping(func(err error) (error, bool) {
+		if errors.Is(err, target) {
+			return target, true
+		}
+
+		return nil, false
+	}, mapping)
+}
+
+// RegisterErrorType maps Go errors with an E in their chain, as found by
+// errors.As, to JS errors described by mapping.
+func RegisterErrorType[E error](mapping ErrorMapping) {
+	registerErrorMapping(func(err error) (error, bool) {
+		var target E
+		if errors.As(err, &target) {
+			return target, true
+		}
+
+		return nil, false
+	}, mapping)
+}
+
+func registerErrorMapping(match func(err error) (error, bool), mapping ErrorMapping) {
+	errorMappingsLock.Lock()
+	defer errorMappingsLock.Unlock()
+
+	errorMappings = append(errorMappings, errorMappingEntry{
+		match:   match,
+		mapping: mapping,
+	})
+}
+
+// lookupErrorMapping returns the mapping for err, and the properties it sets.
+func lookupErrorMapping(err error) (ErrorMapping, map[string]any) {
+	errorMappingsLock.RLock()
+	defer errorMappingsLock.RUnlock()
+
+	var mapping ErrorMapping
+	var properties map[string]any
+	for _, entry := range errorMappings {
+		matched, ok := entry.match(err)
+		if !ok {
+			continue
+		}
+
+		mapping = entry.mapping
+		if len(mapping.Properties) > 0 || mapping.PropertiesOf != nil {
+			properties = make(map[string]any, len(mapping.Properties))
+			for name, value := range mapping.Properties {
+				properties[name] = value
+			}
+
+			if mapping.PropertiesOf != nil {
+				for name, value := range mapping.PropertiesOf(matched) {
+					properties[name] = value
+				}
+			}
+		}
+
+		break
+	}
+
+	if mapping.Code == "" {
+		var coder JSCoder
+		if errors.As(err, &coder) {
+			mapping.Code = coder.JSCode()
+		} else {
+			mapping.Code = napi.ErrorCodeOf(err)
+		}
+	}
+
+	return mapping, properties
+}
+
+// newGoError converts a Go error to a JS error, according to the registered
+// mappings. An *Exception is converted back to the value that was thrown.
+//
+// The error wrapped by err becomes the cause property of the JS error, and
+// errors that wrap multiple errors, such as those created by errors.Join,
+// become an AggregateError of the wrapped errors.
+func (e Env) newGoError(err error) (Value, error) {
+	return e.newGoErrorDepth(err, 0)
+}
+
+func (e Env) newGoErrorDepth(err error, depth int) (Value, error) {
+	if exc, ok := err.(*Exception); ok {
+		return exc.Value, nil
+	}
+
+	mapping, properties := lookupErrorMapping(err)
+
+	var jsErr Error
+	var newErr error
+	if joined, ok := err.(interface{ Unwrap() []error }); ok && depth < maxErrorDepth {
+		jsErr, newErr = e.newAggregateError(mapping.Code, err.Error(), joined.Unwrap(), depth)
+	} else if cls, ok, clsErr := e.mappedErrorClass(mapping); clsErr != nil {
+		return Value{}, clsErr
+	} else if ok {
+		jsErr, newErr = e.newErrorOfClass(cls, mapping.Code, err.Error())
+	} else {
+		switch mapping.Class {
+		case ErrorClassTypeError:
+			jsErr, newErr = e.NewTypeError(mapping.Code, err.Error())
+		case ErrorClassRangeError:
+			jsErr, newErr = e.NewRangeError(mapping.Code, err.Error())
+		case ErrorClassSyntaxError:
+			jsErr, newErr = e.NewSyntaxError(mapping.Code, err.Error())
+		default:
+			jsErr, newErr = e.NewError(mapping.Code, err.Error())
+		}
+	}
+
+	if newErr != nil {
+		return Value{}, newErr
+	}
+
+	obj := jsErr.Value.AsObjectUnsafe()
+	if cause := errors.Unwrap(err); cause != nil && depth < maxErrorDepth {
+		causeValue, err := e.newGoErrorDepth(cause, depth+1)
+		if err != nil {
+			return Value{}, err
+		}
+
+		// like new Error(message, { cause }), cause is not enumerable
+		if err := obj.DefineProperty(PropertyDescriptor{
+			Name:         "cause",
+			Value:        causeValue,
+			Writable:     true,
+			Configurable: true,
+		}); err != nil {
+			return Value{}, err
+		}
+	}
+
+	for name, value := range properties {
+		if err := obj.SetNamed(name, value); err != nil {
+			return Value{}, err
+		}
+	}
+
+	return jsErr.Value, nil
+}
+
+func (e Env) mappedErrorClass(mapping ErrorMapping) (Function, bool, error) {
+	if mapping.ClassName == "" {
+		return Function{}, false, nil
+	}
+
+	return e.DefinedErrorClass(mapping.ClassName)
+}
+
+// newErrorOfClass creates an instance of an error class, like
+// new cls(message).
+func (e Env) newErrorOfClass(cls Function, code string, message string) (Error, error) {
+	obj, err := cls.New(message)
+	if err != nil {
+		return Error{}, err
+	}
+
+	if code != "" {
+		if err := obj.SetNamed("code", code); err != nil {
+			return Error{}, err
+		}
+	}
+
+	return obj.Value.AsErrorUnsafe(), nil
+}
+
+// newAggregateError creates an AggregateError of errs, like
+// new AggregateError(errs, message).
+func (e Env) newAggregateError(
+	code string,
+	message string,
+	errs []error,
+	depth int,
+) (Error, error) {
+	values := make([]Value, len(errs))
+	for i, err := range errs {
+		value, convErr := e.newGoErrorDepth(err, depth+1)
+		if convErr != nil {
+			return Error{}, convErr
+		}
+
+		values[i] = value
+	}
+
+	constructor, err := e.aggregateErrorConstructor()
+	if err != nil {
+		return Error{}, err
+	}
+
+	obj, err := constructor.New(values, message)
+	if err != nil {
+		return Error{}, err
+	}
+
+	if code != "" {
+		if err := obj.SetNamed("code", code); err != nil {
+			return Error{}, err
+		}
+	}
+
+	return obj.Value.AsErrorUnsafe(), nil
+}
+
+// ThrowGoError throws a Go error as a JS error, converted according to the
+// registered mappings like Env.ValueOf. An *Exception is rethrown as-is, and
+// an error wrapping one is thrown with the exception as its cause.
+func (e Env) ThrowGoError(err error) error {
+	v, convErr := e.newGoError(err)
+	if convErr != nil {
+		// fall back to a plain error, so something is still thrown
+		return e.ThrowError(napi.ErrorCodeOf(err), err.Error())
+	}
+
+	return e.statusError("napi_throw", napi.Throw(e.Env, v.Value))
+}
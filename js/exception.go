@@ -0,0 +1,228 @@
+package js
+
+import (
+	"errors"
+
+	"github.com/akshayganeshen/napi-go"
+)
+
+// maxErrorDepth limits how deep cause chains and aggregated errors are
+// converted between Go and JS, since they may be cyclic.
+const maxErrorDepth = 32
+
+// Exception is a JS exception caught by Go code, e.g. one thrown by a function
+// invoked with Function.Call. The thrown value is kept in Value, and is only
+// valid until the current callback returns unless a Ref is created for it.
+//
+// The remaining fields hold the corresponding string properties of the thrown
+// value, if any. For thrown values that are not objects, Message holds the
+// value converted to a string.
+type Exception struct {
+	Value Value
+
+	Name    string
+	Message string
+	Code    string
+	Stack   string
+
+	// Cause is the cause property of the thrown value, if any.
+	Cause error
+
+	// Errors are the errors of a thrown AggregateError.
+	Errors []error
+}
+
+var _ error = &Exception{}
+
+func (e Env) IsExceptionPending() (bool, error) {
+	b, st := napi.IsExceptionPending(e.Env)
+	if err := e.statusError("napi_is_exception_pending", st); err != nil {
+		return false, err
+	}
+
+	return b, nil
+}
+
+// CatchException clears the pending JS exception and returns it, or returns
+// nil if no exception is pending.
+func (e Env) CatchException() (*Exception, error) {
+	if pending, err := e.IsExceptionPending(); err != nil {
+		return nil, err
+	} else if !pending {
+		return nil, nil
+	}
+
+	v, st := napi.GetAndClearLastException(e.Env)
+	if err := e.statusError("napi_get_and_clear_last_exception", st); err != nil {
+		return nil, err
+	}
+
+	return e.newException(e.WrapValue(v)), nil
+}
+
+// FatalException triggers an uncaughtException in JS, e.g. for errors that
+// occur in callbacks that have no JS caller to throw to.
+func (e Env) FatalException(err AnyValue) error {
+	return e.statusError("napi_fatal_exception", napi.FatalException(e.Env, err.GetValue().Value))
+}
+
+// Throw rethrows the exception in JS.
+func (exc *Exception) Throw() error {
+	return exc.Value.Env.statusError("napi_throw", napi.Throw(exc.Value.Env.Env, exc.Value.Value))
+}
+
+// Unwrap returns the cause of the exception, joined with the errors of an
+// AggregateError, so errors.Is and errors.As can inspect them.
+func (exc *Exception) Unwrap() error {
+	if len(exc.Errors) == 0 {
+		return exc.Cause
+	}
+
+	if exc.Cause == nil {
+		return errors.Join(exc.Errors...)
+	}
+
+	return errors.Join(append([]error{exc.Cause}, exc.Errors...)...)
+}
+
+func (exc *Exception) Error() string {
+	switch {
+	case exc.Name == "" && exc.Message == "":
+		return "uncaught JS exception"
+	case exc.Name == "":
+		return exc.Message
+	case exc.Message == "":
+		return exc.Name
+	default:
+		return exc.Name + ": " + exc.Message
+	}
+}
+
+// callError is like statusError, but catches the pending exception as an
+// Exception for napi.StatusPendingException.
+func (e Env) callError(op string, st napi.Status) error {
+	// catching the exception calls into napi, so the status error is created
+	// first, while the extended error information is still for op
+	err := e.statusError(op, st)
+	if st == napi.StatusPendingException {
+		if exc, catchErr := e.CatchException(); catchErr == nil && exc != nil {
+			return exc
+		}
+	}
+
+	return err
+}
+
+func (e Env) newException(v Value) *Exception {
+	return e.newExceptionDepth(v, 0)
+}
+
+func (e Env) newExceptionDepth(v Value, depth int) *Exception {
+	exc := &Exception{
+		Value: v,
+	}
+
+	if ok, err := v.IsObject(); err != nil || !ok {
+		exc.Message, _ = v.IntoGoString()
+		e.discardException()
+		return exc
+	}
+
+	obj := v.AsObjectUnsafe()
+	exc.Name = obj.stringProperty("name")
+	exc.Message = obj.stringProperty("message")
+	exc.Code = obj.stringProperty("code")
+	exc.Stack = obj.stringProperty("stack")
+
+	if depth >= maxErrorDepth {
+		return exc
+	}
+
+	if cause, ok := obj.optionalProperty("cause"); ok {
+		exc.Cause = e.newExceptionDepth(cause, depth+1)
+	}
+
+	if errs, ok := obj.optionalProperty("errors"); ok && e.isAggregateError(v) {
+		if arr, err := errs.AsArray(); err == nil {
+			values, _ := arr.Values()
+			for _, value := range values {
+				exc.Errors = append(exc.Errors, e.newExceptionDepth(value, depth+1))
+			}
+		}
+	}
+
+	return exc
+}
+
+// isAggregateError reports whether v is an instance of the global
+// AggregateError, including its subclasses.
+func (e Env) isAggregateError(v Value) bool {
+	constructor, err := e.aggregateErrorConstructor()
+	if err != nil {
+		e.discardException()
+		return false
+	}
+
+	ok, err := v.InstanceOf(constructor)
+	if err != nil {
+		// Symbol.hasInstance may throw
+		e.discardException()
+		return false
+	}
+
+	return ok
+}
+
+func (e Env) aggregateErrorConstructor() (Function, error) {
+	global, err := e.GetGlobal()
+	if err != nil {
+		return Function{}, err
+	}
+
+	constructor, err := global.GetNamed("AggregateError")
+	if err != nil {
+		return Function{}, err
+	}
+
+	return constructor.AsFunction()
+}
+
+// optionalProperty returns the named property, unless it is undefined or
+// could not be read.
+func (o Object) optionalProperty(name string) (Value, bool) {
+	value, err := o.GetNamed(name)
+	if err != nil {
+		o.Env.discardException()
+		return Value{}, false
+	}
+
+	if undefined, err := value.IsUndefined(); err != nil || undefined {
+		return Value{}, false
+	}
+
+	return value, true
+}
+
+// stringProperty returns the named property if it is a string, or "" if it is
+// not or could not be read.
+func (o Object) stringProperty(name string) string {
+	value, err := o.GetNamed(name)
+	if err != nil {
+		// getters may throw, which must not leak into the caller
+		o.Env.discardException()
+		return ""
+	}
+
+	s, err := value.AsString()
+	if err != nil {
+		return ""
+	}
+
+	return s
+}
+
+func (e Env) discardException() {
+	if pending, _ := napi.IsExceptionPending(e.Env); pending {
+		napi.GetAndClearLastException(e.Env)
+	}
+}
@@ -0,0 +1,84 @@
+package js
+
+import (
+	"reflect"
+
+	"github.com/akshayganeshen/napi-go"
+)
+
+// External is a JS value holding an opaque Go value of type T. The Go value
+// is released once the JS value is garbage collected.
+//
+// If the Go value implements ExternalMemorySizer, its size is reported to the
+// engine until then.
+type External[T any] struct {
+	Value
+}
+
+func (v Value) IsExternal() (bool, error) {
+	t, err := v.GetType()
+	if err != nil {
+		return false, err
+	}
+
+	return t == napi.ValueTypeExternal, nil
+}
+
+func NewExternal[T any](env Env, data T) (External[T], error) {
+	memory := newExternalMemory(data)
+	v, st := napi.CreateExternal(env.Env, data, memory.finalize, nil)
+	if err := env.statusError("napi_create_external", st); err != nil {
+		return External[T]{}, err
+	}
+
+	if err := memory.report(env); err != nil {
+		return External[T]{}, err
+	}
+
+	value := env.WrapValue(v)
+	if err := value.AsObjectUnsafe().SetTypeTag(externalTypeTag[T]()); err != nil {
+		return External[T]{}, err
+	}
+
+	return External[T]{
+		Value: value,
+	}, nil
+}
+
+// AsExternal returns the Go value held by an external created with
+// NewExternal, failing with ErrWrongType if v is not an external or holds a
+// value of a different type.
+func AsExternal[T any](v Value) (T, error) {
+	var zero T
+	if ok, err := v.IsExternal(); err != nil {
+		return zero, err
+	} else if !ok {
+		return zero, ErrWrongType
+	}
+
+	if ok, err := v.AsObjectUnsafe().HasTypeTag(externalTypeTag[T]()); err != nil {
+		return zero, err
+	} else if !ok {
+		return zero, ErrWrongType
+	}
+
+	data, st := napi.GetValueExternal(v.Env.Env, v.Value)
+	if err := v.Env.statusError("napi_get_value_external", st); err != nil {
+		return zero, err
+	}
+
+	result, ok := data.(T)
+	if !ok {
+		return zero, ErrWrongType
+	}
+
+	return result, nil
+}
+
+func (e External[T]) Get() (T, error) {
+	return AsExternal[T](e.Value)
+}
+
+func externalTypeTag[T any]() napi.TypeTag {
+	return TypeTagOf(reflect.TypeOf((*T)(nil)).Elem())
+}
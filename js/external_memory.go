@@ -0,0 +1,67 @@
+package js
+
+import (
+	"github.com/akshayganeshen/napi-go"
+)
+
+// ExternalMemorySizer is implemented by Go values that keep memory alive
+// outside of the JS heap. When such a value is held by an External or a Class
+// instance, its size is reported to the engine for as long as the JS value is
+// alive, so the engine can account for it when scheduling garbage collection.
+type ExternalMemorySizer interface {
+	ExternalMemorySize() int64
+}
+
+// AdjustExternalMemory reports a change in the amount of memory kept alive by
+// JS objects, but allocated outside of the JS heap, returning the adjusted
+// total.
+func (e Env) AdjustExternalMemory(changeInBytes int64) (int64, error) {
+	total, st := napi.AdjustExternalMemory(e.Env, changeInBytes)
+	if err := e.statusError("napi_adjust_external_memory", st); err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+// externalMemory is memory reported for a JS value, which is un-reported
+// when the value is finalized.
+type externalMemory struct {
+	size     int64
+	reported bool
+}
+
+func newExternalMemory(data any) *externalMemory {
+	var size int64
+	if sizer, ok := data.(ExternalMemorySizer); ok {
+		size = sizer.ExternalMemorySize()
+	}
+
+	return &externalMemory{
+		size: size,
+	}
+}
+
+// report reports the memory once the JS value is created. It must not be
+// called if the value was not created, or if its finalizer already ran.
+func (m *externalMemory) report(env Env) error {
+	if m.size <= 0 {
+		return nil
+	}
+
+	if _, err := env.AdjustExternalMemory(m.size); err != nil {
+		return err
+	}
+
+	m.reported = true
+	return nil
+}
+
+func (m *externalMemory) finalize(env napi.Env, finalizeData, finalizeHint any) {
+	if !m.reported {
+		return
+	}
+
+	m.reported = false
+	napi.AdjustExternalMemory(env, -m.size)
+}
@@ -46,7 +46,7 @@ func (e Env) NewFunction(fn any) (Function, error) {
 		cb,
 	)
 
-	if err := st.AsError(); err != nil {
+	if err := e.statusError("napi_create_function", st); err != nil {
 		return Function{}, err
 	}
 
@@ -55,6 +55,8 @@ func (e Env) NewFunction(fn any) (Function, error) {
 	}, nil
 }
 
+// Call calls the function with this and args. If the function throws, the
+// returned error is an *Exception holding the thrown value.
 func (f Function) Call(this any, args ...any) (Value, error) {
 	thisValue, err := f.Env.ValueOf(this)
 	if err != nil {
@@ -72,13 +74,33 @@ func (f Function) Call(this any, args ...any) (Value, error) {
 	}
 
 	result, st := napi.CallFunction(f.Env.Env, thisValue.Value, f.Value.Value, argValues)
-	if err := st.AsError(); err != nil {
+	if err := f.Env.callError("napi_call_function", st); err != nil {
 		return Value{}, err
 	}
 
 	return f.Env.WrapValue(result), nil
 }
 
+// New calls the function as a constructor, like the new operator.
+func (f Function) New(args ...any) (Object, error) {
+	argValues := make([]napi.Value, len(args))
+	for i, arg := range args {
+		value, err := f.Env.ValueOf(arg)
+		if err != nil {
+			return Object{}, err
+		}
+
+		argValues[i] = value.Value
+	}
+
+	result, st := napi.NewInstance(f.Env.Env, f.Value.Value, argValues)
+	if err := f.Env.callError("napi_new_instance", st); err != nil {
+		return Object{}, err
+	}
+
+	return f.Env.WrapValue(result).AsObjectUnsafe(), nil
+}
+
 type Finalizer interface {
 	Finalize(env Env, data any)
 }
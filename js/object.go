@@ -35,7 +35,7 @@ func (v Value) AsObject() (Object, error) {
 
 func (e Env) NewObject() (Object, error) {
 	v, st := napi.CreateObject(e.Env)
-	if err := st.AsError(); err != nil {
+	if err := e.statusError("napi_create_object", st); err != nil {
 		return Object{}, err
 	}
 
@@ -46,7 +46,7 @@ func (e Env) NewObject() (Object, error) {
 
 func (o Object) HasProperty(key Value) (bool, error) {
 	b, st := napi.HasProperty(o.Env.Env, o.Value.Value, key.Value)
-	if err := st.AsError(); err != nil {
+	if err := o.Env.statusError("napi_has_property", st); err != nil {
 		return false, err
 	}
 
@@ -55,7 +55,7 @@ func (o Object) HasProperty(key Value) (bool, error) {
 
 func (o Object) HasOwnProperty(key Value) (bool, error) {
 	b, st := napi.HasOwnProperty(o.Env.Env, o.Value.Value, key.Value)
-	if err := st.AsError(); err != nil {
+	if err := o.Env.statusError("napi_has_own_property", st); err != nil {
 		return false, err
 	}
 
@@ -64,7 +64,7 @@ func (o Object) HasOwnProperty(key Value) (bool, error) {
 
 func (o Object) Get(key Value) (Value, error) {
 	result, st := napi.GetProperty(o.Env.Env, o.Value.Value, key.Value)
-	if err := st.AsError(); err != nil {
+	if err := o.Env.statusError("napi_get_property", st); err != nil {
 		return Value{}, err
 	}
 
@@ -81,7 +81,21 @@ func (o Object) GetNamed(name string) (Value, error) {
 }
 
 func (o Object) Set(key, value Value) error {
-	return napi.SetProperty(o.Env.Env, o.Value.Value, key.Value, value.Value).AsError()
+	return o.Env.statusError("napi_set_property", napi.SetProperty(o.Env.Env, o.Value.Value, key.Value, value.Value))
+}
+
+func (o Object) SetNamed(name string, value any) error {
+	nameValue, err := o.Env.ValueOf(name)
+	if err != nil {
+		return err
+	}
+
+	v, err := o.Env.ValueOf(value)
+	if err != nil {
+		return err
+	}
+
+	return o.Set(nameValue, v)
 }
 
 func (o Object) CallNamed(name string, args ...any) (Value, error) {
@@ -106,3 +120,123 @@ func (o Object) Call(key Value, args ...any) (Value, error) {
 
 	return f.Call(o, args...)
 }
+
+type ObjectEntry struct {
+	Key   Value
+	Value Value
+}
+
+// Keys returns the object's own enumerable string-keyed property names, in the
+// same order as Object.keys.
+func (o Object) Keys() ([]Value, error) {
+	names, st := napi.GetAllPropertyNames(
+		o.Env.Env,
+		o.Value.Value,
+		napi.KeyOwnOnly,
+		napi.KeyEnumerable|napi.KeySkipSymbols,
+		napi.KeyNumbersToStrings,
+	)
+	if err := o.Env.statusError("napi_get_all_property_names", st); err != nil {
+		return nil, err
+	}
+
+	return o.Env.WrapValue(names).AsArrayUnsafe().Values()
+}
+
+// PropertyNames returns the enumerable string-keyed property names of the
+// object and its prototype chain, in the same order as a for...in loop.
+func (o Object) PropertyNames() ([]Value, error) {
+	names, st := napi.GetPropertyNames(o.Env.Env, o.Value.Value)
+	if err := o.Env.statusError("napi_get_property_names", st); err != nil {
+		return nil, err
+	}
+
+	return o.Env.WrapValue(names).AsArrayUnsafe().Values()
+}
+
+func (o Object) Entries() ([]ObjectEntry, error) {
+	var entries []ObjectEntry
+	err := o.Range(func(key, value Value) bool {
+		entries = append(entries, ObjectEntry{
+			Key:   key,
+			Value: value,
+		})
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// Range calls fn for each of the object's own enumerable string-keyed
+// properties, stopping early if fn returns false.
+func (o Object) Range(fn func(key, value Value) bool) error {
+	keys, err := o.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		value, err := o.Get(key)
+		if err != nil {
+			return err
+		}
+
+		if !fn(key, value) {
+			break
+		}
+	}
+
+	return nil
+}
+
+func (o Object) Delete(key Value) (bool, error) {
+	b, st := napi.DeleteProperty(o.Env.Env, o.Value.Value, key.Value)
+	if err := o.Env.statusError("napi_delete_property", st); err != nil {
+		return false, err
+	}
+
+	return b, nil
+}
+
+func (o Object) DeleteNamed(name string) (bool, error) {
+	nameValue, err := o.Env.ValueOf(name)
+	if err != nil {
+		return false, err
+	}
+
+	return o.Delete(nameValue)
+}
+
+func (o Object) HasElement(index int) (bool, error) {
+	b, st := napi.HasElement(o.Env.Env, o.Value.Value, index)
+	if err := o.Env.statusError("napi_has_element", st); err != nil {
+		return false, err
+	}
+
+	return b, nil
+}
+
+func (o Object) GetElement(index int) (Value, error) {
+	result, st := napi.GetElement(o.Env.Env, o.Value.Value, index)
+	if err := o.Env.statusError("napi_get_element", st); err != nil {
+		return Value{}, err
+	}
+
+	return o.Env.WrapValue(result), nil
+}
+
+func (o Object) SetElement(index int, value Value) error {
+	return o.Env.statusError("napi_set_element", napi.SetElement(o.Env.Env, o.Value.Value, index, value.Value))
+}
+
+func (o Object) DeleteElement(index int) (bool, error) {
+	b, st := napi.DeleteElement(o.Env.Env, o.Value.Value, index)
+	if err := o.Env.statusError("napi_delete_element", st); err != nil {
+		return false, err
+	}
+
+	return b, nil
+}
@@ -10,7 +10,7 @@ type Promise struct {
 
 func (v Value) IsPromise() (bool, error) {
 	b, st := napi.IsPromise(v.Env.Env, v.Value)
-	if err := st.AsError(); err != nil {
+	if err := v.Env.statusError("napi_is_promise", st); err != nil {
 		return false, err
 	}
 
@@ -31,12 +31,15 @@ func (d Deferred) Resolve(value any) error {
 
 	st := napi.ResolveDeferred(d.Env.Env, d.Deferred, v.Value)
 	if st != napi.StatusOK {
-		return napi.StatusError(st)
+		return st.AsErrorFor(d.Env.Env, "napi_resolve_deferred")
 	}
 
 	return nil
 }
 
+// Reject rejects the promise with value. Go errors are converted to JS errors
+// like Env.ValueOf, according to the mappings registered with
+// js.RegisterError and js.RegisterErrorType.
 func (d Deferred) Reject(value any) error {
 	v, err := d.Env.ValueOf(value)
 	if err != nil {
@@ -45,7 +48,7 @@ func (d Deferred) Reject(value any) error {
 
 	st := napi.RejectDeferred(d.Env.Env, d.Deferred, v.Value)
 	if st != napi.StatusOK {
-		return napi.StatusError(st)
+		return st.AsErrorFor(d.Env.Env, "napi_reject_deferred")
 	}
 
 	return nil
@@ -70,10 +73,13 @@ func (c promiseTsfnContext) CallJs(env js.Env, fn js.Value, data any) error {
 	return c.settler.Settle(env, Deferred{Env: env, Deferred: c.deferred}, data)
 }
 
+// NewPromise creates a promise that is settled from any goroutine with
+// Promise.Settle. The settler runs on the JS thread, within the async context
+// of the caller of NewPromise.
 func NewPromise(env js.Env, settler Settler) (Promise, error) {
 	p, st := napi.CreatePromise(env.Env)
 	if st != napi.StatusOK {
-		return Promise{}, napi.StatusError(st)
+		return Promise{}, st.AsErrorFor(env.Env, "napi_create_promise")
 	}
 
 	tsfn, err := env.NewThreadsafeFunction(
@@ -0,0 +1,103 @@
+package js
+
+import (
+	"github.com/akshayganeshen/napi-go"
+)
+
+// PropertyDescriptor describes a property for Object.DefineProperties.
+//
+// A descriptor defines an accessor property if Getter or Setter is set, a
+// method if Method is set, and a data property holding Value otherwise.
+// Getter, Setter and Method accept any function supported by Callback.
+type PropertyDescriptor struct {
+	// Name is the property key, unless Key is set.
+	Name string
+	// Key is the property key, for keys that are not strings (e.g. symbols).
+	Key Value
+
+	Value  any
+	Method any
+	Getter any
+	Setter any
+
+	// Writable is ignored for accessor properties.
+	Writable     bool
+	Enumerable   bool
+	Configurable bool
+
+	// Static defines the property on the constructor rather than the
+	// prototype. It is only used by Env.DefineClass.
+	Static bool
+}
+
+func (o Object) DefineProperty(property PropertyDescriptor) error {
+	return o.DefineProperties(property)
+}
+
+func (o Object) DefineProperties(properties ...PropertyDescriptor) error {
+	napiProperties := make([]napi.PropertyDescriptor, len(properties))
+	for i, property := range properties {
+		napiProperty, err := o.Env.napiPropertyDescriptor(property)
+		if err != nil {
+			return err
+		}
+
+		napiProperties[i] = napiProperty
+	}
+
+	return o.Env.statusError("napi_define_properties", napi.DefineProperties(
+		o.Env.Env,
+		o.Value.Value,
+		napiProperties,
+	))
+}
+
+func (e Env) napiPropertyDescriptor(
+	property PropertyDescriptor,
+) (napi.PropertyDescriptor, error) {
+	result := napi.PropertyDescriptor{
+		Utf8Name: property.Name,
+		Name:     property.Key.Value,
+	}
+
+	if property.Writable {
+		result.Attributes |= napi.PropertyWritable
+	}
+	if property.Enumerable {
+		result.Attributes |= napi.PropertyEnumerable
+	}
+	if property.Configurable {
+		result.Attributes |= napi.PropertyConfigurable
+	}
+	if property.Static {
+		result.Attributes |= napi.PropertyStatic
+	}
+
+	var err error
+	if property.Method != nil {
+		if result.Method, err = Callback(property.Method); err != nil {
+			return napi.PropertyDescriptor{}, err
+		}
+	}
+	if property.Getter != nil {
+		if result.Getter, err = Callback(property.Getter); err != nil {
+			return napi.PropertyDescriptor{}, err
+		}
+	}
+	if property.Setter != nil {
+		if result.Setter, err = Callback(property.Setter); err != nil {
+			return napi.PropertyDescriptor{}, err
+		}
+	}
+
+	if result.Method == nil && result.Getter == nil && result.Setter == nil {
+		value, err := e.ValueOf(property.Value)
+		if err != nil {
+			return napi.PropertyDescriptor{}, err
+		}
+
+		result.Value = value.Value
+	}
+
+	return result, nil
+}
@@ -11,7 +11,7 @@ type Ref struct {
 
 func (v Value) NewRef() (Ref, error) {
 	ref, st := napi.CreateReference(v.Env.Env, v.Value, 1)
-	if err := st.AsError(); err != nil {
+	if err := v.Env.statusError("napi_create_reference", st); err != nil {
 		return Ref{}, err
 	}
 
@@ -27,7 +27,7 @@ func (r Ref) Valid() bool {
 
 func (r Ref) GetValue() (Value, error) {
 	value, st := napi.GetReferenceValue(r.Env.Env, r.Reference)
-	if err := st.AsError(); err != nil {
+	if err := r.Env.statusError("napi_get_reference_value", st); err != nil {
 		return Value{}, err
 	}
 
@@ -36,7 +36,7 @@ func (r Ref) GetValue() (Value, error) {
 
 func (r Ref) Ref() (int, error) {
 	n, st := napi.ReferenceRef(r.Env.Env, r.Reference)
-	if err := st.AsError(); err != nil {
+	if err := r.Env.statusError("napi_reference_ref", st); err != nil {
 		return 0, err
 	}
 
@@ -45,7 +45,7 @@ func (r Ref) Ref() (int, error) {
 
 func (r Ref) Unref() (int, error) {
 	n, st := napi.ReferenceUnref(r.Env.Env, r.Reference)
-	if err := st.AsError(); err != nil {
+	if err := r.Env.statusError("napi_reference_unref", st); err != nil {
 		return 0, err
 	}
 
@@ -55,3 +55,12 @@ func (r Ref) Unref() (int, error) {
 
 	return n, nil
 }
+
+func (r Ref) Delete() error {
+	st := napi.DeleteReference(r.Env.Env, r.Reference)
+	if err := r.Env.statusError("napi_delete_reference", st); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package js
+
+import (
+	"errors"
+
+	"github.com/akshayganeshen/napi-go"
+)
+
+// createRequireScript evaluates to a function that creates a require function
+// for a file, since addons are not given one of their own. Without
+// process.getBuiltinModule, the 'module' built-in can only be loaded through
+// a CommonJS main module, which ESM entry points and worker threads lack, so
+// the function returns undefined instead.
+const createRequireScript = `(function (filename) {
+	const Module = typeof process.getBuiltinModule === "function"
+		? process.getBuiltinModule("module")
+		: process.mainModule && process.mainModule.require("module");
+
+	return Module ? Module.createRequire(filename) : undefined;
+})`
+
+// ErrRequireUnsupported is returned by Require on Node versions without
+// process.getBuiltinModule, i.e. before Node 20.16 and 22.3, unless it is
+// called on the main thread of a process started from a CommonJS module.
+var ErrRequireUnsupported = errors.New("Require: unsupported Node version: process.getBuiltinModule is not available")
+
+type requireCacheKey struct{}
+
+// requireCache is the per-env state for Require.
+type requireCache struct {
+	require Ref
+	modules map[string]Ref
+}
+
+// Require loads a module like require(specifier) would in a JS file next to
+// the addon, e.g. "fs" or "./lib/glue.js". Modules are cached per env.
+//
+// On Node versions without process.getBuiltinModule, Require fails with
+// ErrRequireUnsupported in worker threads and in processes started from an
+// ES module.
+func (e Env) Require(specifier string) (Object, error) {
+	cache, err := e.requireCache()
+	if err != nil {
+		return Object{}, err
+	}
+
+	if ref, ok := cache.modules[specifier]; ok {
+		v, err := ref.GetValue()
+		if err != nil {
+			return Object{}, err
+		}
+
+		return v.AsObjectUnsafe(), nil
+	}
+
+	requireValue, err := cache.require.GetValue()
+	if err != nil {
+		return Object{}, err
+	}
+
+	v, err := requireValue.AsFunctionUnsafe().Call(nil, specifier)
+	if err != nil {
+		return Object{}, err
+	}
+
+	// modules such as "events" export a function rather than an object
+	if t, err := v.GetType(); err != nil {
+		return Object{}, err
+	} else if t != napi.ValueTypeObject && t != napi.ValueTypeFunction {
+		return Object{}, ErrWrongType
+	}
+
+	ref, err := v.NewRef()
+	if err != nil {
+		return Object{}, err
+	}
+
+	cache.modules[specifier] = ref
+	return v.AsObjectUnsafe(), nil
+}
+
+func (e Env) requireCache() (*requireCache, error) {
+	data, st := napi.GetKeyedInstanceData(e.Env, requireCacheKey{})
+	if err := e.statusError("napi_get_instance_data", st); err != nil {
+		return nil, err
+	}
+
+	if cache, ok := data.(*requireCache); ok {
+		return cache, nil
+	}
+
+	filename, st := napi.GetModuleFileName(e.Env)
+	if err := e.statusError("node_api_get_module_file_name", st); err != nil {
+		return nil, err
+	}
+
+	createRequire, err := e.RunScript(createRequireScript)
+	if err != nil {
+		return nil, err
+	}
+
+	requireValue, err := createRequire.AsFunctionUnsafe().Call(nil, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	if ok, err := requireValue.IsUndefined(); err != nil {
+		return nil, err
+	} else if ok {
+		return nil, ErrRequireUnsupported
+	}
+
+	requireRef, err := requireValue.NewRef()
+	if err != nil {
+		return nil, err
+	}
+
+	cache := &requireCache{
+		require: requireRef,
+		modules: make(map[string]Ref),
+	}
+
+	st = napi.SetKeyedInstanceData(e.Env, requireCacheKey{}, cache)
+	if err := e.statusError("napi_set_instance_data", st); err != nil {
+		return nil, err
+	}
+
+	return cache, nil
+}
@@ -0,0 +1,92 @@
+package js
+
+import (
+	"github.com/akshayganeshen/napi-go"
+)
+
+// valueOfScopeBatchSize is the number of elements Env.ValueOf converts within
+// each handle scope when converting large slices and maps.
+const valueOfScopeBatchSize = 256
+
+// WithScope calls fn within a new handle scope. Values created by fn are
+// released once it returns, so they must not be used afterwards unless they
+// were stored in an object that outlives the scope or in a Ref.
+func (e Env) WithScope(fn func() error) (err error) {
+	scope, st := napi.OpenHandleScope(e.Env)
+	if err := e.statusError("napi_open_handle_scope", st); err != nil {
+		return err
+	}
+
+	defer func() {
+		st := napi.CloseHandleScope(e.Env, scope)
+		if err == nil {
+			err = e.statusError("napi_close_handle_scope", st)
+		}
+	}()
+
+	return fn()
+}
+
+// WithEscapableScope is like WithScope, but the Value returned by fn remains
+// valid after the scope is closed.
+func (e Env) WithEscapableScope(fn func() (Value, error)) (result Value, err error) {
+	scope, st := napi.OpenEscapableHandleScope(e.Env)
+	if err := e.statusError("napi_open_escapable_handle_scope", st); err != nil {
+		return Value{}, err
+	}
+
+	defer func() {
+		st := napi.CloseEscapableHandleScope(e.Env, scope)
+		if err == nil {
+			err = e.statusError("napi_close_escapable_handle_scope", st)
+		}
+	}()
+
+	value, err := fn()
+	if err != nil {
+		return Value{}, err
+	}
+
+	escaped, st := napi.EscapeHandle(e.Env, scope, value.Value)
+	if err := e.statusError("napi_escape_handle", st); err != nil {
+		return Value{}, err
+	}
+
+	return e.WrapValue(escaped), nil
+}
+
+// withScopeBatches calls fn for each index in [0, n), opening a new handle
+// scope for each batch of indices once n is large enough to warrant it.
+func (e Env) withScopeBatches(n int, fn func(i int) error) error {
+	if n <= valueOfScopeBatchSize {
+		for i := 0; i < n; i++ {
+			if err := fn(i); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	for start := 0; start < n; start += valueOfScopeBatchSize {
+		end := start + valueOfScopeBatchSize
+		if end > n {
+			end = n
+		}
+
+		err := e.WithScope(func() error {
+			for i := start; i < end; i++ {
+				if err := fn(i); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package js
+
+import (
+	"github.com/akshayganeshen/napi-go"
+)
+
+// RunScript evaluates source as a JS script in the global scope, returning
+// the value of its last expression. If the script throws, the exception is
+// returned as an *Exception.
+func (e Env) RunScript(source string) (Value, error) {
+	script, st := napi.CreateStringUtf8(e.Env, source)
+	if err := e.statusError("napi_create_string_utf8", st); err != nil {
+		return Value{}, err
+	}
+
+	v, st := napi.RunScript(e.Env, script)
+	if err := e.callError("napi_run_script", st); err != nil {
+		return Value{}, err
+	}
+
+	return e.WrapValue(v), nil
+}
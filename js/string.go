@@ -0,0 +1,86 @@
+package js
+
+import (
+	"github.com/akshayganeshen/napi-go"
+)
+
+// AsUTF16 returns the UTF-16 code units of a string, e.g. to preserve unpaired
+// surrogates that cannot be represented in UTF-8.
+func (v Value) AsUTF16() ([]uint16, error) {
+	if ok, err := v.IsString(); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, ErrWrongType
+	}
+
+	str, st := napi.GetValueStringUtf16(v.Env.Env, v.Value)
+	if err := v.Env.statusError("napi_get_value_string_utf16", st); err != nil {
+		return nil, err
+	}
+
+	return str, nil
+}
+
+// AsLatin1 returns a string encoded as Latin-1. Characters outside of Latin-1
+// are truncated to their lower 8 bits.
+func (v Value) AsLatin1() ([]byte, error) {
+	if ok, err := v.IsString(); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, ErrWrongType
+	}
+
+	str, st := napi.GetValueStringLatin1(v.Env.Env, v.Value)
+	if err := v.Env.statusError("napi_get_value_string_latin1", st); err != nil {
+		return nil, err
+	}
+
+	return str, nil
+}
+
+// NewStringLatin1 creates a string from Latin-1 encoded bytes.
+func (e Env) NewStringLatin1(str []byte) (Value, error) {
+	v, st := napi.CreateStringLatin1(e.Env, str)
+	if err := e.statusError("napi_create_string_latin1", st); err != nil {
+		return Value{}, err
+	}
+
+	return e.WrapValue(v), nil
+}
+
+// NewExternalStringLatin1 creates a string backed by str, without copying it
+// if the engine supports it. str must not be modified afterwards, and its size
+// is reported to the engine until the string is garbage collected.
+func (e Env) NewExternalStringLatin1(str []byte) (Value, error) {
+	memory := &externalMemory{size: int64(len(str))}
+	v, copied, st := napi.CreateExternalStringLatin1(e.Env, str, memory.finalize, nil)
+	if err := e.statusError("node_api_create_external_string_latin1", st); err != nil {
+		return Value{}, err
+	}
+
+	if !copied {
+		if err := memory.report(e); err != nil {
+			return Value{}, err
+		}
+	}
+
+	return e.WrapValue(v), nil
+}
+
+// NewExternalStringUTF16 is like NewExternalStringLatin1, for UTF-16 code
+// units.
+func (e Env) NewExternalStringUTF16(str []uint16) (Value, error) {
+	memory := &externalMemory{size: int64(len(str) * 2)}
+	v, copied, st := napi.CreateExternalStringUtf16(e.Env, str, memory.finalize, nil)
+	if err := e.statusError("node_api_create_external_string_utf16", st); err != nil {
+		return Value{}, err
+	}
+
+	if !copied {
+		if err := memory.report(e); err != nil {
+			return Value{}, err
+		}
+	}
+
+	return e.WrapValue(v), nil
+}
@@ -16,6 +16,8 @@ func (f TsfnContextFunc) CallJs(env Env, fn Value, data any) error {
 	return f(env, fn, data)
 }
 
+// tsfnCallJsWrapper needs no callback scope of its own, as napi already runs
+// it within the async context of the threadsafe function's async resource.
 func tsfnCallJsWrapper(e napi.Env, callback napi.Value, context any, data any) {
 	env := WrapEnv(e)
 	err := context.(TsfnContext).CallJs(
@@ -24,7 +26,7 @@ func tsfnCallJsWrapper(e napi.Env, callback napi.Value, context any, data any) {
 		data,
 	)
 	if err != nil {
-		if err2 := env.ThrowError("", err.Error()); err2 != nil {
+		if err2 := env.ThrowGoError(err); err2 != nil {
 			log.Println("WARN: threadsafe_function_call_js_wrapper: an error occurred while handling another")
 			log.Println("WARN: threadsafe_function_call_js_wrapper: original", err)
 			log.Println("WARN: threadsafe_function_call_js_wrapper: secondary", err2)
@@ -50,7 +52,7 @@ func tsfnFinalizerWrapper(e napi.Env, data any, hint any) {
 	env := WrapEnv(e)
 	err := data.(TsfnFinalizer).Finalize(env, hint.(TsfnContext))
 	if err != nil {
-		if err2 := env.ThrowError("", err.Error()); err2 != nil {
+		if err2 := env.ThrowGoError(err); err2 != nil {
 			log.Println("WARN: threadsafe_function_finalizer_wrapper: an error occurred while handling another")
 			log.Println("WARN: threadsafe_function_finalizer_wrapper: original", err)
 			log.Println("WARN: threadsafe_function_finalizer_wrapper: secondary", err2)
@@ -73,6 +75,10 @@ func defaultTsfnCallJs(env Env, fn Value, data any) error {
 
 var DefaultTsfnContext = TsfnContextFunc(defaultTsfnCallJs)
 
+// NewThreadsafeFunction creates a function that can be called from any
+// goroutine, to call into JS on the JS thread through context. napi calls JS
+// within the async context of the caller of NewThreadsafeFunction, so
+// AsyncLocalStorage and async_hooks see it as a continuation of the caller.
 func (e Env) NewThreadsafeFunction(v AnyValue, name string, context TsfnContext, finalizer TsfnFinalizer) (ThreadsafeFunction, error) {
 	var value napi.Value
 	if v != nil {
@@ -101,7 +107,7 @@ func (e Env) NewThreadsafeFunction(v AnyValue, name string, context TsfnContext,
 		tsfnCallJsWrapper,
 	)
 	if st != napi.StatusOK {
-		return ThreadsafeFunction{}, napi.StatusError(st)
+		return ThreadsafeFunction{}, e.statusError("napi_create_threadsafe_function", st)
 	}
 
 	return ThreadsafeFunction{
@@ -0,0 +1,98 @@
+package js
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"reflect"
+	"runtime/debug"
+	"sync"
+
+	"github.com/akshayganeshen/napi-go"
+)
+
+var typeTagCache sync.Map // map[reflect.Type]napi.TypeTag
+
+// typeTagSalt is the path of the addon's main package. Every addon has its
+// own Go runtime, and its types are usually in package main, so without the
+// salt two addons would produce the same tags, and one could unwrap a
+// cgo.Handle belonging to the other.
+var typeTagSalt = newTypeTagSalt()
+
+func newTypeTagSalt() string {
+	if info, ok := debug.ReadBuildInfo(); ok {
+		return info.Path
+	}
+
+	return ""
+}
+
+// TypeTagOf returns the type tag used for JS values holding Go values of type
+// t. The tag is derived from the package path and name of t, and from the
+// path of the addon's main package, so it is the same every time the addon
+// loads, while values from another addon loaded in the same process never
+// match.
+func TypeTagOf(t reflect.Type) napi.TypeTag {
+	if tag, ok := typeTagCache.Load(t); ok {
+		return tag.(napi.TypeTag)
+	}
+
+	h := sha256.New()
+	h.Write([]byte(typeTagSalt))
+	h.Write([]byte("napi-go:" + typeIdentity(t)))
+	sum := h.Sum(nil)
+	tag := napi.TypeTag{
+		Lower: binary.LittleEndian.Uint64(sum[0:8]),
+		Upper: binary.LittleEndian.Uint64(sum[8:16]),
+	}
+
+	typeTagCache.Store(t, tag)
+	return tag
+}
+
+func typeIdentity(t reflect.Type) string {
+	switch {
+	case t.Name() != "" && t.PkgPath() != "":
+		return t.PkgPath() + "." + t.Name()
+	case t.Kind() == reflect.Ptr:
+		return "*" + typeIdentity(t.Elem())
+	default:
+		return t.String()
+	}
+}
+
+// SetTypeTag marks the object with tag. An object can only be tagged once.
+func (o Object) SetTypeTag(tag napi.TypeTag) error {
+	return o.Env.statusError("napi_type_tag_object", napi.TypeTagObject(o.Env.Env, o.Value.Value, tag))
+}
+
+func (o Object) HasTypeTag(tag napi.TypeTag) (bool, error) {
+	b, st := napi.CheckObjectTypeTag(o.Env.Env, o.Value.Value, tag)
+	if err := o.Env.statusError("napi_check_object_type_tag", st); err != nil {
+		return false, err
+	}
+
+	return b, nil
+}
+
+// unwrapTagged unwraps a value created with a wrapped Go value of type t,
+// refusing values that were not tagged with the tag for t.
+func unwrapTagged(v Value, t reflect.Type) (any, error) {
+	if ok, err := v.IsObject(); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, ErrWrongType
+	}
+
+	if ok, err := v.AsObjectUnsafe().HasTypeTag(TypeTagOf(t)); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, ErrWrongType
+	}
+
+	wrapped, st := napi.Unwrap(v.Env.Env, v.Value)
+	if err := v.Env.statusError("napi_unwrap", st); err != nil {
+		return nil, err
+	}
+
+	return wrapped, nil
+}
@@ -0,0 +1,35 @@
+package js
+
+import (
+	"reflect"
+	"testing"
+)
+
+type tagA struct{}
+
+type tagB struct{}
+
+func TestTypeTagOf(t *testing.T) {
+	a := TypeTagOf(reflect.TypeOf(tagA{}))
+	if again := TypeTagOf(reflect.TypeOf(tagA{})); again != a {
+		t.Errorf("TypeTagOf(tagA) changed: %v, then %v", a, again)
+	}
+
+	if b := TypeTagOf(reflect.TypeOf(tagB{})); b == a {
+		t.Errorf("TypeTagOf(tagB) = TypeTagOf(tagA) = %v", a)
+	}
+
+	if ptr := TypeTagOf(reflect.TypeOf(&tagA{})); ptr == a {
+		t.Errorf("TypeTagOf(*tagA) = TypeTagOf(tagA) = %v", a)
+	}
+}
+
+func TestTypeTagSalt(t *testing.T) {
+	if typeTagSalt == "" {
+		t.Fatal("typeTagSalt is empty")
+	}
+
+	if other := newTypeTagSalt(); other != typeTagSalt {
+		t.Errorf("newTypeTagSalt() = %q, then %q", typeTagSalt, other)
+	}
+}
@@ -41,7 +41,7 @@ func (v Value) Valid() bool {
 
 func (v Value) GetType() (napi.ValueType, error) {
 	vt, st := napi.Typeof(v.Env.Env, v.Value)
-	if err := st.AsError(); err != nil {
+	if err := v.Env.statusError("napi_typeof", st); err != nil {
 		return napi.ValueTypeUndefined, err
 	}
 
@@ -101,7 +101,7 @@ func (v Value) AsBool() (bool, error) {
 	}
 
 	b, st := napi.GetValueBool(v.Env.Env, v.Value)
-	if err := st.AsError(); err != nil {
+	if err := v.Env.statusError("napi_get_value_bool", st); err != nil {
 		return false, err
 	}
 
@@ -117,6 +117,21 @@ func (v Value) IsNumber() (bool, error) {
 	return t == napi.ValueTypeNumber, nil
 }
 
+func (v Value) AsFloat64() (float64, error) {
+	if ok, err := v.IsNumber(); err != nil {
+		return 0, err
+	} else if !ok {
+		return 0, ErrWrongType
+	}
+
+	f, st := napi.GetValueDouble(v.Env.Env, v.Value)
+	if err := v.Env.statusError("napi_get_value_double", st); err != nil {
+		return 0, err
+	}
+
+	return f, nil
+}
+
 func (v Value) IsBigint() (bool, error) {
 	t, err := v.GetType()
 	if err != nil {
@@ -131,7 +146,7 @@ func (v Value) AsInt64() (int64, error) {
 		return 0, err
 	} else if ok {
 		n, st := napi.GetValueInt64(v.Env.Env, v.Value)
-		if err := st.AsError(); err != nil {
+		if err := v.Env.statusError("napi_get_value_int64", st); err != nil {
 			return 0, err
 		}
 
@@ -145,7 +160,7 @@ func (v Value) AsInt64() (int64, error) {
 	}
 
 	n, lossless, st := napi.GetValueBigintInt64(v.Env.Env, v.Value)
-	if err := st.AsError(); err != nil {
+	if err := v.Env.statusError("napi_get_value_bigint_int64", st); err != nil {
 		return 0, err
 	}
 
@@ -173,16 +188,25 @@ func (v Value) AsString() (string, error) {
 	}
 
 	str, st := napi.GetValueStringUtf8(v.Env.Env, v.Value)
-	if err := st.AsError(); err != nil {
+	if err := v.Env.statusError("napi_get_value_string_utf8", st); err != nil {
 		return "", err
 	}
 
 	return str, nil
 }
 
+func (v Value) InstanceOf(constructor AnyValue) (bool, error) {
+	b, st := napi.Instanceof(v.Env.Env, v.Value, constructor.GetValue().Value)
+	if err := v.Env.statusError("napi_instanceof", st); err != nil {
+		return false, err
+	}
+
+	return b, nil
+}
+
 func (v Value) IntoBool() (Value, error) {
 	result, st := napi.CoerceToBool(v.Env.Env, v.Value)
-	if err := st.AsError(); err != nil {
+	if err := v.Env.statusError("napi_coerce_to_bool", st); err != nil {
 		return Value{}, err
 	}
 
@@ -191,7 +215,7 @@ func (v Value) IntoBool() (Value, error) {
 
 func (v Value) IntoNumber() (Value, error) {
 	result, st := napi.CoerceToNumber(v.Env.Env, v.Value)
-	if err := st.AsError(); err != nil {
+	if err := v.Env.statusError("napi_coerce_to_number", st); err != nil {
 		return Value{}, err
 	}
 
@@ -200,7 +224,7 @@ func (v Value) IntoNumber() (Value, error) {
 
 func (v Value) IntoObject() (Value, error) {
 	result, st := napi.CoerceToObject(v.Env.Env, v.Value)
-	if err := st.AsError(); err != nil {
+	if err := v.Env.statusError("napi_coerce_to_object", st); err != nil {
 		return Value{}, err
 	}
 
@@ -209,7 +233,7 @@ func (v Value) IntoObject() (Value, error) {
 
 func (v Value) IntoString() (Value, error) {
 	result, st := napi.CoerceToString(v.Env.Env, v.Value)
-	if err := st.AsError(); err != nil {
+	if err := v.Env.statusError("napi_coerce_to_string", st); err != nil {
 		return Value{}, err
 	}
 
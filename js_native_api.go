@@ -3,6 +3,155 @@ package napi
 /*
 #include <stdlib.h>
 #include <node/node_api.h>
+
+// External strings and property keys are experimental, and the syntax error
+// functions need Node-API version 9. When building against headers without
+// them, these fall back to the equivalent stable functions. Strings are then
+// copied, which the external string API allows the engine to do anyway.
+static napi_status napiGoCreateExternalStringLatin1(
+	napi_env env,
+	char* str,
+	size_t length,
+	napi_finalize finalize_callback,
+	void* finalize_hint,
+	napi_value* result,
+	bool* copied
+) {
+#ifdef NAPI_EXPERIMENTAL
+	return node_api_create_external_string_latin1(
+		env,
+		str,
+		length,
+		finalize_callback,
+		finalize_hint,
+		result,
+		copied
+	);
+#else
+	napi_status status = napi_create_string_latin1(env, str, length, result);
+	if (status == napi_ok) {
+		*copied = true;
+		if (finalize_callback != NULL) {
+			finalize_callback(env, str, finalize_hint);
+		}
+	}
+
+	return status;
+#endif
+}
+
+static napi_status napiGoCreateExternalStringUtf16(
+	napi_env env,
+	char16_t* str,
+	size_t length,
+	napi_finalize finalize_callback,
+	void* finalize_hint,
+	napi_value* result,
+	bool* copied
+) {
+#ifdef NAPI_EXPERIMENTAL
+	return node_api_create_external_string_utf16(
+		env,
+		str,
+		length,
+		finalize_callback,
+		finalize_hint,
+		result,
+		copied
+	);
+#else
+	napi_status status = napi_create_string_utf16(env, str, length, result);
+	if (status == napi_ok) {
+		*copied = true;
+		if (finalize_callback != NULL) {
+			finalize_callback(env, str, finalize_hint);
+		}
+	}
+
+	return status;
+#endif
+}
+
+static napi_status napiGoCreatePropertyKeyUtf16(
+	napi_env env,
+	const char16_t* str,
+	size_t length,
+	napi_value* result
+) {
+#ifdef NAPI_EXPERIMENTAL
+	return node_api_create_property_key_utf16(env, str, length, result);
+#else
+	return napi_create_string_utf16(env, str, length, result);
+#endif
+}
+
+static napi_status napiGoCreateSyntaxError(
+	napi_env env,
+	napi_value code,
+	napi_value msg,
+	napi_value* result
+) {
+#if NAPI_VERSION >= 9
+	return node_api_create_syntax_error(env, code, msg, result);
+#else
+	napi_value global, constructor, error;
+	napi_status status = napi_get_global(env, &global);
+	if (status != napi_ok) {
+		return status;
+	}
+
+	status = napi_get_named_property(env, global, "SyntaxError", &constructor);
+	if (status != napi_ok) {
+		return status;
+	}
+
+	status = napi_new_instance(env, constructor, 1, &msg, &error);
+	if (status != napi_ok) {
+		return status;
+	}
+
+	if (code != NULL) {
+		status = napi_set_named_property(env, error, "code", code);
+		if (status != napi_ok) {
+			return status;
+		}
+	}
+
+	*result = error;
+	return napi_ok;
+#endif
+}
+
+static napi_status napiGoThrowSyntaxError(
+	napi_env env,
+	const char* code,
+	const char* msg
+) {
+#if NAPI_VERSION >= 9
+	return node_api_throw_syntax_error(env, code, msg);
+#else
+	napi_value codeValue = NULL, msgValue, error;
+	napi_status status;
+	if (code != NULL) {
+		status = napi_create_string_utf8(env, code, NAPI_AUTO_LENGTH, &codeValue);
+		if (status != napi_ok) {
+			return status;
+		}
+	}
+
+	status = napi_create_string_utf8(env, msg, NAPI_AUTO_LENGTH, &msgValue);
+	if (status != napi_ok) {
+		return status;
+	}
+
+	status = napiGoCreateSyntaxError(env, codeValue, msgValue, &error);
+	if (status != napi_ok) {
+		return status;
+	}
+
+	return napi_throw(env, error);
+#endif
+}
 */
 import "C"
 
@@ -76,6 +225,26 @@ func CreateArrayWithLength(env Env, length int) (Value, Status) {
 	return result, status
 }
 
+func IsArray(env Env, value Value) (bool, Status) {
+	var result bool
+	status := Status(C.napi_is_array(
+		C.napi_env(env),
+		C.napi_value(value),
+		(*C.bool)(unsafe.Pointer(&result)),
+	))
+	return result, status
+}
+
+func GetArrayLength(env Env, value Value) (int, Status) {
+	var result C.uint32_t
+	status := Status(C.napi_get_array_length(
+		C.napi_env(env),
+		C.napi_value(value),
+		&result,
+	))
+	return int(result), status
+}
+
 func CreateDouble(env Env, value float64) (Value, Status) {
 	var result Value
 	status := Status(C.napi_create_double(
@@ -86,6 +255,52 @@ func CreateDouble(env Env, value float64) (Value, Status) {
 	return result, status
 }
 
+func CreateBigintInt64(env Env, value int64) (Value, Status) {
+	var result Value
+	status := Status(C.napi_create_bigint_int64(
+		C.napi_env(env),
+		C.int64_t(value),
+		(*C.napi_value)(unsafe.Pointer(&result)),
+	))
+	return result, status
+}
+
+func CreateBigintUint64(env Env, value uint64) (Value, Status) {
+	var result Value
+	status := Status(C.napi_create_bigint_uint64(
+		C.napi_env(env),
+		C.uint64_t(value),
+		(*C.napi_value)(unsafe.Pointer(&result)),
+	))
+	return result, status
+}
+
+// CreateBigintWords creates a BigInt from its magnitude, given as 64-bit words
+// in little-endian order, and its sign.
+func CreateBigintWords(env Env, negative bool, words []uint64) (Value, Status) {
+	defer runtime.KeepAlive(words)
+
+	var signBit C.int
+	if negative {
+		signBit = 1
+	}
+
+	if len(words) == 0 {
+		// napi rejects a nil words pointer, even for zero
+		words = []uint64{0}
+	}
+
+	var result Value
+	status := Status(C.napi_create_bigint_words(
+		C.napi_env(env),
+		signBit,
+		C.size_t(len(words)),
+		(*C.uint64_t)(unsafe.Pointer(&words[0])),
+		(*C.napi_value)(unsafe.Pointer(&result)),
+	))
+	return result, status
+}
+
 func CreateStringUtf8(env Env, str string) (Value, Status) {
 	cstr := C.CString(str)
 	defer C.free(unsafe.Pointer(cstr))
@@ -100,6 +315,127 @@ func CreateStringUtf8(env Env, str string) (Value, Status) {
 	return result, status
 }
 
+func CreateStringLatin1(env Env, str []byte) (Value, Status) {
+	var strPtr *C.char
+	if len(str) > 0 {
+		strPtr = (*C.char)(unsafe.Pointer(&str[0])) // must pass element pointer
+	}
+
+	var result Value
+	status := Status(C.napi_create_string_latin1(
+		C.napi_env(env),
+		strPtr,
+		C.size_t(len(str)),
+		(*C.napi_value)(unsafe.Pointer(&result)),
+	))
+	return result, status
+}
+
+func CreateStringUtf16(env Env, str []uint16) (Value, Status) {
+	var strPtr *C.char16_t
+	if len(str) > 0 {
+		strPtr = (*C.char16_t)(unsafe.Pointer(&str[0])) // must pass element pointer
+	}
+
+	var result Value
+	status := Status(C.napi_create_string_utf16(
+		C.napi_env(env),
+		strPtr,
+		C.size_t(len(str)), // must pass number of code units
+		(*C.napi_value)(unsafe.Pointer(&result)),
+	))
+	return result, status
+}
+
+// CreateExternalStringLatin1 creates a string backed by str without copying
+// it, if the engine supports it. The returned bool reports whether str was
+// copied instead, in which case finalize has already been invoked.
+//
+// str is pinned, and must not be modified, until finalize is invoked.
+func CreateExternalStringLatin1(
+	env Env,
+	str []byte,
+	finalize FinalizeFn,
+	finalizeHint any,
+) (Value, bool, Status) {
+	var strPtr *C.char
+	if len(str) > 0 {
+		strPtr = (*C.char)(unsafe.Pointer(&str[0])) // must pass element pointer
+	}
+
+	hint := wrapPinnedFinalizeFnHint(finalize, str, finalizeHint, unsafe.Pointer(strPtr))
+
+	var result Value
+	var copied C.bool
+	status := Status(C.napiGoCreateExternalStringLatin1(
+		C.napi_env(env),
+		strPtr,
+		C.size_t(len(str)),
+		(*[0]byte)(_cCallWrappedFinalizeHintFn),
+		hint,
+		(*C.napi_value)(unsafe.Pointer(&result)),
+		&copied,
+	))
+	if status != StatusOK {
+		deleteFinalizeFnHint(hint)
+		return nil, false, status
+	}
+
+	return result, bool(copied), status
+}
+
+// CreateExternalStringUtf16 is like CreateExternalStringLatin1, for UTF-16
+// code units.
+func CreateExternalStringUtf16(
+	env Env,
+	str []uint16,
+	finalize FinalizeFn,
+	finalizeHint any,
+) (Value, bool, Status) {
+	var strPtr *C.char16_t
+	if len(str) > 0 {
+		strPtr = (*C.char16_t)(unsafe.Pointer(&str[0])) // must pass element pointer
+	}
+
+	hint := wrapPinnedFinalizeFnHint(finalize, str, finalizeHint, unsafe.Pointer(strPtr))
+
+	var result Value
+	var copied C.bool
+	status := Status(C.napiGoCreateExternalStringUtf16(
+		C.napi_env(env),
+		strPtr,
+		C.size_t(len(str)),
+		(*[0]byte)(_cCallWrappedFinalizeHintFn),
+		hint,
+		(*C.napi_value)(unsafe.Pointer(&result)),
+		&copied,
+	))
+	if status != StatusOK {
+		deleteFinalizeFnHint(hint)
+		return nil, false, status
+	}
+
+	return result, bool(copied), status
+}
+
+// CreatePropertyKeyUtf16 creates an internalized string, which is faster to
+// use repeatedly as a property key than a regular string.
+func CreatePropertyKeyUtf16(env Env, str []uint16) (Value, Status) {
+	var strPtr *C.char16_t
+	if len(str) > 0 {
+		strPtr = (*C.char16_t)(unsafe.Pointer(&str[0])) // must pass element pointer
+	}
+
+	var result Value
+	status := Status(C.napiGoCreatePropertyKeyUtf16(
+		C.napi_env(env),
+		strPtr,
+		C.size_t(len(str)),
+		(*C.napi_value)(unsafe.Pointer(&result)),
+	))
+	return result, status
+}
+
 func CreateSymbol(env Env, description Value) (Value, Status) {
 	var result Value
 	status := Status(C.napi_create_symbol(
@@ -119,6 +455,121 @@ func CreateFunction(env Env, name string, cb Callback) (Value, Status) {
 	return provider.GetCallbackData().CreateCallback(env, name, cb)
 }
 
+func DefineClass(
+	env Env,
+	name string,
+	constructor Callback,
+	properties []PropertyDescriptor,
+) (Value, Status) {
+	provider, status := getInstanceData(env)
+	if status != StatusOK || provider == nil {
+		return nil, status
+	}
+
+	return provider.GetCallbackData().DefineClass(env, name, constructor, properties)
+}
+
+// Wrap associates nativeObject with jsObject, so it can be retrieved later
+// using Unwrap. The finalizer is invoked with nativeObject and finalizeHint
+// once jsObject is garbage collected, unless the wrap is removed first.
+func Wrap(
+	env Env,
+	jsObject Value,
+	nativeObject any,
+	finalize FinalizeFn,
+	finalizeHint any,
+) Status {
+	// the same handle is used for both the native object and the hint, so
+	// the finalizer can release it
+	hint := wrapFinalizeFnHint(finalize, nativeObject, finalizeHint)
+	status := Status(C.napi_wrap(
+		C.napi_env(env),
+		C.napi_value(jsObject),
+		hint,
+		(*[0]byte)(_cCallWrappedFinalizeHintFn),
+		hint,
+		nil,
+	))
+	if status != StatusOK {
+		deleteFinalizeFnHint(hint)
+	}
+
+	return status
+}
+
+func Unwrap(env Env, jsObject Value) (any, Status) {
+	var result unsafe.Pointer
+	status := Status(C.napi_unwrap(
+		C.napi_env(env),
+		C.napi_value(jsObject),
+		&result,
+	))
+	if status != StatusOK {
+		return nil, status
+	}
+
+	return finalizeFnHintData(result), status
+}
+
+// RemoveWrap removes the wrap from jsObject, returning the native object. The
+// finalizer passed to Wrap will no longer be invoked.
+func RemoveWrap(env Env, jsObject Value) (any, Status) {
+	var result unsafe.Pointer
+	status := Status(C.napi_remove_wrap(
+		C.napi_env(env),
+		C.napi_value(jsObject),
+		&result,
+	))
+	if status != StatusOK {
+		return nil, status
+	}
+
+	return deleteFinalizeFnHint(result), status
+}
+
+// CreateExternal creates a JS value holding data, which can be retrieved later
+// using GetValueExternal. The finalizer is invoked with data and finalizeHint
+// once the value is garbage collected.
+func CreateExternal(
+	env Env,
+	data any,
+	finalize FinalizeFn,
+	finalizeHint any,
+) (Value, Status) {
+	// the same handle is used for both the data and the hint, so the
+	// finalizer can release it
+	hint := wrapFinalizeFnHint(finalize, data, finalizeHint)
+
+	var result Value
+	status := Status(C.napi_create_external(
+		C.napi_env(env),
+		hint,
+		(*[0]byte)(_cCallWrappedFinalizeHintFn),
+		hint,
+		(*C.napi_value)(unsafe.Pointer(&result)),
+	))
+	if status != StatusOK {
+		deleteFinalizeFnHint(hint)
+		return nil, status
+	}
+
+	return result, status
+}
+
+func GetValueExternal(env Env, value Value) (any, Status) {
+	var result unsafe.Pointer
+	status := Status(C.napi_get_value_external(
+		C.napi_env(env),
+		C.napi_value(value),
+		&result,
+	))
+	if status != StatusOK {
+		return nil, status
+	}
+
+	return finalizeFnHintData(result), status
+}
+
 func CreateError(env Env, code, msg Value) (Value, Status) {
 	var result Value
 	status := Status(C.napi_create_error(
@@ -130,6 +581,39 @@ func CreateError(env Env, code, msg Value) (Value, Status) {
 	return result, status
 }
 
+func CreateTypeError(env Env, code, msg Value) (Value, Status) {
+	var result Value
+	status := Status(C.napi_create_type_error(
+		C.napi_env(env),
+		C.napi_value(code),
+		C.napi_value(msg),
+		(*C.napi_value)(unsafe.Pointer(&result)),
+	))
+	return result, status
+}
+
+func CreateRangeError(env Env, code, msg Value) (Value, Status) {
+	var result Value
+	status := Status(C.napi_create_range_error(
+		C.napi_env(env),
+		C.napi_value(code),
+		C.napi_value(msg),
+		(*C.napi_value)(unsafe.Pointer(&result)),
+	))
+	return result, status
+}
+
+func CreateSyntaxError(env Env, code, msg Value) (Value, Status) {
+	var result Value
+	status := Status(C.napiGoCreateSyntaxError(
+		C.napi_env(env),
+		C.napi_value(code),
+		C.napi_value(msg),
+		(*C.napi_value)(unsafe.Pointer(&result)),
+	))
+	return result, status
+}
+
 func Typeof(env Env, value Value) (ValueType, Status) {
 	var result ValueType
 	status := Status(C.napi_typeof(
@@ -182,6 +666,59 @@ func GetValueBigintInt64(env Env, value Value) (int64, bool, Status) {
 	return result, lossless, status
 }
 
+func GetValueBigintUint64(env Env, value Value) (uint64, bool, Status) {
+	var result uint64
+	var lossless bool
+	status := Status(C.napi_get_value_bigint_uint64(
+		C.napi_env(env),
+		C.napi_value(value),
+		(*C.uint64_t)(unsafe.Pointer(&result)),
+		(*C.bool)(unsafe.Pointer(&lossless)),
+	))
+	return result, lossless, status
+}
+
+// GetValueBigintWords returns the sign and magnitude of a BigInt, with the
+// magnitude given as 64-bit words in little-endian order.
+func GetValueBigintWords(env Env, value Value) (bool, []uint64, Status) {
+	// call napi_get_value_bigint_words twice
+	// first is to get number of words
+	// second is to populate the actual words
+	var wordCount C.size_t
+	status := Status(C.napi_get_value_bigint_words(
+		C.napi_env(env),
+		C.napi_value(value),
+		nil,
+		&wordCount,
+		nil,
+	))
+
+	if status != StatusOK {
+		return false, nil, status
+	}
+
+	words := make([]uint64, int(wordCount))
+	var wordsPtr *C.uint64_t
+	if wordCount > 0 {
+		wordsPtr = (*C.uint64_t)(unsafe.Pointer(&words[0]))
+	}
+
+	var signBit C.int
+	status = Status(C.napi_get_value_bigint_words(
+		C.napi_env(env),
+		C.napi_value(value),
+		&signBit,
+		&wordCount,
+		wordsPtr,
+	))
+
+	if status != StatusOK {
+		return false, nil, status
+	}
+
+	return signBit != 0, words[:wordCount], status
+}
+
 func GetValueBool(env Env, value Value) (bool, Status) {
 	var result bool
 	status := Status(C.napi_get_value_bool(
@@ -234,31 +771,181 @@ func GetValueStringUtf8(env Env, value Value) (string, Status) {
 	), status
 }
 
-func SetProperty(env Env, object, key, value Value) Status {
-	return Status(C.napi_set_property(
+func GetValueStringLatin1(env Env, value Value) ([]byte, Status) {
+	// like napi_get_value_string_utf8, the first call gets the length
+	var strsize C.size_t
+	status := Status(C.napi_get_value_string_latin1(
 		C.napi_env(env),
-		C.napi_value(object),
-		C.napi_value(key),
 		C.napi_value(value),
+		nil,
+		0,
+		&strsize,
 	))
-}
 
-func SetElement(env Env, object Value, index int, value Value) Status {
-	return Status(C.napi_set_element(
+	if status != StatusOK {
+		return nil, status
+	}
+
+	// ensure there is room for the null terminator as well
+	buf := make([]byte, strsize+1)
+	status = Status(C.napi_get_value_string_latin1(
 		C.napi_env(env),
-		C.napi_value(object),
-		C.uint32_t(index),
 		C.napi_value(value),
+		(*C.char)(unsafe.Pointer(&buf[0])),
+		C.size_t(len(buf)),
+		&strsize,
 	))
+
+	if status != StatusOK {
+		return nil, status
+	}
+
+	return buf[:strsize], status
 }
 
-func StrictEquals(env Env, lhs, rhs Value) (bool, Status) {
-	var result bool
-	status := Status(C.napi_strict_equals(
+func GetValueStringUtf16(env Env, value Value) ([]uint16, Status) {
+	// like napi_get_value_string_utf8, the first call gets the length
+	var strsize C.size_t
+	status := Status(C.napi_get_value_string_utf16(
 		C.napi_env(env),
-		C.napi_value(lhs),
-		C.napi_value(rhs),
-		(*C.bool)(&result),
+		C.napi_value(value),
+		nil,
+		0,
+		&strsize,
+	))
+
+	if status != StatusOK {
+		return nil, status
+	}
+
+	// ensure there is room for the null terminator as well
+	buf := make([]uint16, strsize+1)
+	status = Status(C.napi_get_value_string_utf16(
+		C.napi_env(env),
+		C.napi_value(value),
+		(*C.char16_t)(unsafe.Pointer(&buf[0])),
+		C.size_t(len(buf)),
+		&strsize,
+	))
+
+	if status != StatusOK {
+		return nil, status
+	}
+
+	return buf[:strsize], status
+}
+
+func SetProperty(env Env, object, key, value Value) Status {
+	return Status(C.napi_set_property(
+		C.napi_env(env),
+		C.napi_value(object),
+		C.napi_value(key),
+		C.napi_value(value),
+	))
+}
+
+func GetPropertyNames(env Env, object Value) (Value, Status) {
+	var result Value
+	status := Status(C.napi_get_property_names(
+		C.napi_env(env),
+		C.napi_value(object),
+		(*C.napi_value)(unsafe.Pointer(&result)),
+	))
+	return result, status
+}
+
+func GetAllPropertyNames(
+	env Env,
+	object Value,
+	keyMode KeyCollectionMode,
+	keyFilter KeyFilter,
+	keyConversion KeyConversion,
+) (Value, Status) {
+	var result Value
+	status := Status(C.napi_get_all_property_names(
+		C.napi_env(env),
+		C.napi_value(object),
+		C.napi_key_collection_mode(keyMode),
+		C.napi_key_filter(keyFilter),
+		C.napi_key_conversion(keyConversion),
+		(*C.napi_value)(unsafe.Pointer(&result)),
+	))
+	return result, status
+}
+
+func DeleteProperty(env Env, object, key Value) (bool, Status) {
+	var result bool
+	status := Status(C.napi_delete_property(
+		C.napi_env(env),
+		C.napi_value(object),
+		C.napi_value(key),
+		(*C.bool)(unsafe.Pointer(&result)),
+	))
+	return result, status
+}
+
+func SetElement(env Env, object Value, index int, value Value) Status {
+	return Status(C.napi_set_element(
+		C.napi_env(env),
+		C.napi_value(object),
+		C.uint32_t(index),
+		C.napi_value(value),
+	))
+}
+
+func DefineProperties(
+	env Env,
+	object Value,
+	properties []PropertyDescriptor,
+) Status {
+	provider, status := getInstanceData(env)
+	if status != StatusOK || provider == nil {
+		return status
+	}
+
+	return provider.GetCallbackData().DefineProperties(env, object, properties)
+}
+
+func HasElement(env Env, object Value, index int) (bool, Status) {
+	var result bool
+	status := Status(C.napi_has_element(
+		C.napi_env(env),
+		C.napi_value(object),
+		C.uint32_t(index),
+		(*C.bool)(unsafe.Pointer(&result)),
+	))
+	return result, status
+}
+
+func GetElement(env Env, object Value, index int) (Value, Status) {
+	var result Value
+	status := Status(C.napi_get_element(
+		C.napi_env(env),
+		C.napi_value(object),
+		C.uint32_t(index),
+		(*C.napi_value)(unsafe.Pointer(&result)),
+	))
+	return result, status
+}
+
+func DeleteElement(env Env, object Value, index int) (bool, Status) {
+	var result bool
+	status := Status(C.napi_delete_element(
+		C.napi_env(env),
+		C.napi_value(object),
+		C.uint32_t(index),
+		(*C.bool)(unsafe.Pointer(&result)),
+	))
+	return result, status
+}
+
+func StrictEquals(env Env, lhs, rhs Value) (bool, Status) {
+	var result bool
+	status := Status(C.napi_strict_equals(
+		C.napi_env(env),
+		C.napi_value(lhs),
+		C.napi_value(rhs),
+		(*C.bool)(&result),
 	))
 	return result, status
 }
@@ -309,6 +996,16 @@ func GetCbInfo(env Env, info CallbackInfo) (GetCbInfoResult, Status) {
 	}, status
 }
 
+func GetNewTarget(env Env, info CallbackInfo) (Value, Status) {
+	var result Value
+	status := Status(C.napi_get_new_target(
+		C.napi_env(env),
+		C.napi_callback_info(info),
+		(*C.napi_value)(unsafe.Pointer(&result)),
+	))
+	return result, status
+}
+
 func Throw(env Env, err Value) Status {
 	return Status(C.napi_throw(
 		C.napi_env(env),
@@ -317,7 +1014,7 @@ func Throw(env Env, err Value) Status {
 }
 
 func ThrowError(env Env, code, msg string) Status {
-	codeCStr, msgCCstr := C.CString(code), C.CString(msg)
+	codeCStr, msgCCstr := errorCodeCString(code), C.CString(msg)
 	defer C.free(unsafe.Pointer(codeCStr))
 	defer C.free(unsafe.Pointer(msgCCstr))
 
@@ -328,6 +1025,70 @@ func ThrowError(env Env, code, msg string) Status {
 	))
 }
 
+func ThrowTypeError(env Env, code, msg string) Status {
+	codeCStr, msgCCstr := errorCodeCString(code), C.CString(msg)
+	defer C.free(unsafe.Pointer(codeCStr))
+	defer C.free(unsafe.Pointer(msgCCstr))
+
+	return Status(C.napi_throw_type_error(
+		C.napi_env(env),
+		codeCStr,
+		msgCCstr,
+	))
+}
+
+func ThrowRangeError(env Env, code, msg string) Status {
+	codeCStr, msgCCstr := errorCodeCString(code), C.CString(msg)
+	defer C.free(unsafe.Pointer(codeCStr))
+	defer C.free(unsafe.Pointer(msgCCstr))
+
+	return Status(C.napi_throw_range_error(
+		C.napi_env(env),
+		codeCStr,
+		msgCCstr,
+	))
+}
+
+func ThrowSyntaxError(env Env, code, msg string) Status {
+	codeCStr, msgCCstr := errorCodeCString(code), C.CString(msg)
+	defer C.free(unsafe.Pointer(codeCStr))
+	defer C.free(unsafe.Pointer(msgCCstr))
+
+	return Status(C.napiGoThrowSyntaxError(
+		C.napi_env(env),
+		codeCStr,
+		msgCCstr,
+	))
+}
+
+// errorCodeCString converts an error code for the napi_throw_*error functions,
+// where an empty code means the error has no code property.
+func errorCodeCString(code string) *C.char {
+	if code == "" {
+		return nil
+	}
+
+	return C.CString(code)
+}
+
+func IsExceptionPending(env Env) (bool, Status) {
+	var result bool
+	status := Status(C.napi_is_exception_pending(
+		C.napi_env(env),
+		(*C.bool)(unsafe.Pointer(&result)),
+	))
+	return result, status
+}
+
+func GetAndClearLastException(env Env) (Value, Status) {
+	var result Value
+	status := Status(C.napi_get_and_clear_last_exception(
+		C.napi_env(env),
+		(*C.napi_value)(unsafe.Pointer(&result)),
+	))
+	return result, status
+}
+
 func CreatePromise(env Env) (Promise, Status) {
 	var result Promise
 	status := Status(C.napi_create_promise(
@@ -373,6 +1134,28 @@ func GetInstanceData(env Env) (any, Status) {
 	return provider.GetUserData(), status
 }
 
+// SetKeyedInstanceData associates data with key for env, so that packages
+// built on napi can keep per-env state alongside the data set with
+// SetInstanceData. Like context keys, keys should be of an unexported type.
+func SetKeyedInstanceData(env Env, key, data any) Status {
+	provider, status := getInstanceData(env)
+	if status != StatusOK || provider == nil {
+		return status
+	}
+
+	provider.SetKeyedData(key, data)
+	return status
+}
+
+func GetKeyedInstanceData(env Env, key any) (any, Status) {
+	provider, status := getInstanceData(env)
+	if status != StatusOK || provider == nil {
+		return nil, status
+	}
+
+	return provider.GetKeyedData(key), status
+}
+
 func CoerceToString(env Env, value Value) (Value, Status) {
 	var result Value
 	status := Status(C.napi_coerce_to_string(
@@ -443,6 +1226,80 @@ func IsBuffer(env Env, value Value) (bool, Status) {
 	return result, status
 }
 
+func CreateBuffer(env Env, size int) (Value, []byte, Status) {
+	var data unsafe.Pointer
+	var result Value
+	status := Status(C.napi_create_buffer(
+		C.napi_env(env),
+		C.size_t(size),
+		&data,
+		(*C.napi_value)(unsafe.Pointer(&result)),
+	))
+	if status != StatusOK {
+		return nil, nil, status
+	}
+
+	return result, unsafe.Slice((*byte)(data), size), status
+}
+
+func CreateBufferCopy(env Env, data []byte) (Value, []byte, Status) {
+	var dataPtr unsafe.Pointer
+	if len(data) > 0 {
+		dataPtr = unsafe.Pointer(&data[0]) // must pass element pointer
+	}
+
+	var resultData unsafe.Pointer
+	var result Value
+	status := Status(C.napi_create_buffer_copy(
+		C.napi_env(env),
+		C.size_t(len(data)),
+		dataPtr,
+		&resultData,
+		(*C.napi_value)(unsafe.Pointer(&result)),
+	))
+	if status != StatusOK {
+		return nil, nil, status
+	}
+
+	return result, unsafe.Slice((*byte)(resultData), len(data)), status
+}
+
+// CreateExternalBuffer creates a Buffer backed by data without copying it.
+// The data slice is pinned until the Buffer is collected, at which point
+// finalize is invoked with data and finalizeHint.
+//
+// Some runtimes do not allow external buffers, in which case
+// StatusNoExternalBuffersAllowed is returned.
+func CreateExternalBuffer(
+	env Env,
+	data []byte,
+	finalize FinalizeFn,
+	finalizeHint any,
+) (Value, Status) {
+	var dataPtr unsafe.Pointer
+	if len(data) > 0 {
+		dataPtr = unsafe.Pointer(&data[0]) // must pass element pointer
+	}
+
+	hint := wrapPinnedFinalizeFnHint(finalize, data, finalizeHint, dataPtr)
+
+	var result Value
+	status := Status(C.napi_create_external_buffer(
+		C.napi_env(env),
+		C.size_t(len(data)),
+		dataPtr,
+		(*[0]byte)(_cCallWrappedFinalizeHintFn),
+		hint,
+		(*C.napi_value)(unsafe.Pointer(&result)),
+	))
+	if status != StatusOK {
+		deleteFinalizeFnHint(hint)
+		return nil, status
+	}
+
+	return result, status
+}
+
 func GetBufferInfo(env Env, value Value) ([]byte, Status) {
 	var data unsafe.Pointer
 	var length C.size_t
@@ -523,3 +1380,331 @@ func CallFunction(env Env, recv Value, fn Value, args []Value) (Value, Status) {
 
 	return result, status
 }
+
+func IsArraybuffer(env Env, value Value) (bool, Status) {
+	var result bool
+	status := Status(C.napi_is_arraybuffer(
+		C.napi_env(env),
+		C.napi_value(value),
+		(*C.bool)(unsafe.Pointer(&result)),
+	))
+	return result, status
+}
+
+func CreateArraybuffer(env Env, byteLength int) (Value, []byte, Status) {
+	var data unsafe.Pointer
+	var result Value
+	status := Status(C.napi_create_arraybuffer(
+		C.napi_env(env),
+		C.size_t(byteLength),
+		&data,
+		(*C.napi_value)(unsafe.Pointer(&result)),
+	))
+	if status != StatusOK {
+		return nil, nil, status
+	}
+
+	return result, unsafe.Slice((*byte)(data), byteLength), status
+}
+
+// CreateExternalArraybuffer creates an ArrayBuffer backed by data without
+// copying it. The data slice is pinned until the ArrayBuffer is collected, at
+// which point finalize is invoked with data and finalizeHint.
+func CreateExternalArraybuffer(
+	env Env,
+	data []byte,
+	finalize FinalizeFn,
+	finalizeHint any,
+) (Value, Status) {
+	var dataPtr unsafe.Pointer
+	if len(data) > 0 {
+		dataPtr = unsafe.Pointer(&data[0]) // must pass element pointer
+	}
+
+	hint := wrapPinnedFinalizeFnHint(finalize, data, finalizeHint, dataPtr)
+
+	var result Value
+	status := Status(C.napi_create_external_arraybuffer(
+		C.napi_env(env),
+		dataPtr,
+		C.size_t(len(data)),
+		(*[0]byte)(_cCallWrappedFinalizeHintFn),
+		hint,
+		(*C.napi_value)(unsafe.Pointer(&result)),
+	))
+	if status != StatusOK {
+		deleteFinalizeFnHint(hint)
+		return nil, status
+	}
+
+	return result, status
+}
+
+func GetArraybufferInfo(env Env, value Value) ([]byte, Status) {
+	var data unsafe.Pointer
+	var length C.size_t
+	status := Status(C.napi_get_arraybuffer_info(
+		C.napi_env(env),
+		C.napi_value(value),
+		&data,
+		&length,
+	))
+	return unsafe.Slice((*byte)(data), length), status
+}
+
+func DetachArraybuffer(env Env, value Value) Status {
+	return Status(C.napi_detach_arraybuffer(
+		C.napi_env(env),
+		C.napi_value(value),
+	))
+}
+
+func IsDetachedArraybuffer(env Env, value Value) (bool, Status) {
+	var result bool
+	status := Status(C.napi_is_detached_arraybuffer(
+		C.napi_env(env),
+		C.napi_value(value),
+		(*C.bool)(unsafe.Pointer(&result)),
+	))
+	return result, status
+}
+
+func IsTypedarray(env Env, value Value) (bool, Status) {
+	var result bool
+	status := Status(C.napi_is_typedarray(
+		C.napi_env(env),
+		C.napi_value(value),
+		(*C.bool)(unsafe.Pointer(&result)),
+	))
+	return result, status
+}
+
+func CreateTypedarray(
+	env Env,
+	arrayType TypedArrayType,
+	length int,
+	arraybuffer Value,
+	byteOffset int,
+) (Value, Status) {
+	var result Value
+	status := Status(C.napi_create_typedarray(
+		C.napi_env(env),
+		C.napi_typedarray_type(arrayType),
+		C.size_t(length),
+		C.napi_value(arraybuffer),
+		C.size_t(byteOffset),
+		(*C.napi_value)(unsafe.Pointer(&result)),
+	))
+	return result, status
+}
+
+type GetTypedarrayInfoResult struct {
+	Type        TypedArrayType
+	Length      int
+	Data        []byte
+	Arraybuffer Value
+	ByteOffset  int
+}
+
+func GetTypedarrayInfo(env Env, value Value) (GetTypedarrayInfoResult, Status) {
+	var arrayType C.napi_typedarray_type
+	var length C.size_t
+	var data unsafe.Pointer
+	var arraybuffer Value
+	var byteOffset C.size_t
+	status := Status(C.napi_get_typedarray_info(
+		C.napi_env(env),
+		C.napi_value(value),
+		&arrayType,
+		&length,
+		&data,
+		(*C.napi_value)(unsafe.Pointer(&arraybuffer)),
+		&byteOffset,
+	))
+	if status != StatusOK {
+		return GetTypedarrayInfoResult{}, status
+	}
+
+	// data already points at the first element, so the slice spans exactly the
+	// bytes viewed by the typed array
+	t := TypedArrayType(arrayType)
+	return GetTypedarrayInfoResult{
+		Type:        t,
+		Length:      int(length),
+		Data:        unsafe.Slice((*byte)(data), int(length)*t.ElementSize()),
+		Arraybuffer: arraybuffer,
+		ByteOffset:  int(byteOffset),
+	}, status
+}
+
+func IsDataview(env Env, value Value) (bool, Status) {
+	var result bool
+	status := Status(C.napi_is_dataview(
+		C.napi_env(env),
+		C.napi_value(value),
+		(*C.bool)(unsafe.Pointer(&result)),
+	))
+	return result, status
+}
+
+func CreateDataview(
+	env Env,
+	byteLength int,
+	arraybuffer Value,
+	byteOffset int,
+) (Value, Status) {
+	var result Value
+	status := Status(C.napi_create_dataview(
+		C.napi_env(env),
+		C.size_t(byteLength),
+		C.napi_value(arraybuffer),
+		C.size_t(byteOffset),
+		(*C.napi_value)(unsafe.Pointer(&result)),
+	))
+	return result, status
+}
+
+type GetDataviewInfoResult struct {
+	Data        []byte
+	Arraybuffer Value
+	ByteOffset  int
+}
+
+func GetDataviewInfo(env Env, value Value) (GetDataviewInfoResult, Status) {
+	var byteLength C.size_t
+	var data unsafe.Pointer
+	var arraybuffer Value
+	var byteOffset C.size_t
+	status := Status(C.napi_get_dataview_info(
+		C.napi_env(env),
+		C.napi_value(value),
+		&byteLength,
+		&data,
+		(*C.napi_value)(unsafe.Pointer(&arraybuffer)),
+		&byteOffset,
+	))
+	if status != StatusOK {
+		return GetDataviewInfoResult{}, status
+	}
+
+	return GetDataviewInfoResult{
+		Data:        unsafe.Slice((*byte)(data), byteLength),
+		Arraybuffer: arraybuffer,
+		ByteOffset:  int(byteOffset),
+	}, status
+}
+
+func CreateDate(env Env, time float64) (Value, Status) {
+	var result Value
+	status := Status(C.napi_create_date(
+		C.napi_env(env),
+		C.double(time),
+		(*C.napi_value)(unsafe.Pointer(&result)),
+	))
+	return result, status
+}
+
+func IsDate(env Env, value Value) (bool, Status) {
+	var result bool
+	status := Status(C.napi_is_date(
+		C.napi_env(env),
+		C.napi_value(value),
+		(*C.bool)(unsafe.Pointer(&result)),
+	))
+	return result, status
+}
+
+func GetDateValue(env Env, value Value) (float64, Status) {
+	var result float64
+	status := Status(C.napi_get_date_value(
+		C.napi_env(env),
+		C.napi_value(value),
+		(*C.double)(unsafe.Pointer(&result)),
+	))
+	return result, status
+}
+
+func NewInstance(env Env, constructor Value, args []Value) (Value, Status) {
+	defer runtime.KeepAlive(args)
+
+	var argsPtr *C.napi_value
+	if len(args) > 0 {
+		argsPtr = (*C.napi_value)(unsafe.Pointer(&args[0]))
+	}
+
+	var result Value
+	status := Status(C.napi_new_instance(
+		C.napi_env(env),
+		C.napi_value(constructor),
+		C.size_t(len(args)),
+		argsPtr,
+		(*C.napi_value)(unsafe.Pointer(&result)),
+	))
+	if status != StatusOK {
+		return nil, status
+	}
+
+	return result, status
+}
+
+func Instanceof(env Env, object Value, constructor Value) (bool, Status) {
+	var result bool
+	status := Status(C.napi_instanceof(
+		C.napi_env(env),
+		C.napi_value(object),
+		C.napi_value(constructor),
+		(*C.bool)(unsafe.Pointer(&result)),
+	))
+	return result, status
+}
+
+func TypeTagObject(env Env, value Value, tag TypeTag) Status {
+	cTag := C.napi_type_tag{
+		lower: C.uint64_t(tag.Lower),
+		upper: C.uint64_t(tag.Upper),
+	}
+	return Status(C.napi_type_tag_object(
+		C.napi_env(env),
+		C.napi_value(value),
+		&cTag,
+	))
+}
+
+func CheckObjectTypeTag(env Env, value Value, tag TypeTag) (bool, Status) {
+	cTag := C.napi_type_tag{
+		lower: C.uint64_t(tag.Lower),
+		upper: C.uint64_t(tag.Upper),
+	}
+
+	var result bool
+	status := Status(C.napi_check_object_type_tag(
+		C.napi_env(env),
+		C.napi_value(value),
+		&cTag,
+		(*C.bool)(unsafe.Pointer(&result)),
+	))
+	return result, status
+}
+
+// AdjustExternalMemory reports a change in the amount of memory kept alive by
+// JS objects, but allocated outside of the JS heap, returning the adjusted
+// total.
+func AdjustExternalMemory(env Env, changeInBytes int64) (int64, Status) {
+	var result C.int64_t
+	status := Status(C.napi_adjust_external_memory(
+		C.napi_env(env),
+		C.int64_t(changeInBytes),
+		&result,
+	))
+	return int64(result), status
+}
+
+func RunScript(env Env, script Value) (Value, Status) {
+	var result Value
+	status := Status(C.napi_run_script(
+		C.napi_env(env),
+		C.napi_value(script),
+		(*C.napi_value)(unsafe.Pointer(&result)),
+	))
+	return result, status
+}
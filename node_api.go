@@ -1,10 +1,19 @@
 package napi
 
 /*
+#include <stdlib.h>
 #include <node/node_api.h>
 */
 import "C"
 
+import (
+	"unsafe"
+)
+
+// CreateAsyncWork creates work that calls execute on a worker thread, then
+// complete on the JS thread. Node-API calls complete within the async context
+// of asyncResource, which is captured when the work is created, so complete
+// can call into JS without opening a callback scope.
 func CreateAsyncWork(
 	env Env,
 	asyncResource, asyncResourceName Value,
@@ -83,3 +92,25 @@ func GetModuleFileName(env Env) (string, Status) {
 
 	return C.GoString(cresult), status
 }
+
+func FatalException(env Env, err Value) Status {
+	return Status(C.napi_fatal_exception(
+		C.napi_env(env),
+		C.napi_value(err),
+	))
+}
+
+// FatalError reports a fatal error and terminates the process. It does not
+// return.
+func FatalError(location, msg string) {
+	locationCStr, msgCStr := C.CString(location), C.CString(msg)
+	defer C.free(unsafe.Pointer(locationCStr))
+	defer C.free(unsafe.Pointer(msgCStr))
+
+	C.napi_fatal_error(
+		locationCStr,
+		C.size_t(len([]byte(location))),
+		msgCStr,
+		C.size_t(len([]byte(msg))),
+	)
+}
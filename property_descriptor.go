@@ -0,0 +1,37 @@
+package napi
+
+/*
+#include <node/node_api.h>
+*/
+import "C"
+
+type PropertyAttributes int
+
+const (
+	PropertyDefault      PropertyAttributes = C.napi_default
+	PropertyWritable     PropertyAttributes = C.napi_writable
+	PropertyEnumerable   PropertyAttributes = C.napi_enumerable
+	PropertyConfigurable PropertyAttributes = C.napi_configurable
+
+	// PropertyStatic is used with DefineClass to distinguish static properties
+	// from instance properties. It is ignored by DefineProperties.
+	PropertyStatic PropertyAttributes = C.napi_static
+
+	PropertyDefaultMethod     PropertyAttributes = C.napi_default_method
+	PropertyDefaultJsproperty PropertyAttributes = C.napi_default_jsproperty
+)
+
+// PropertyDescriptor describes a single property for DefineProperties.
+// Exactly one of Utf8Name or Name should be set, and at most one of Value,
+// Method or Getter/Setter.
+type PropertyDescriptor struct {
+	Utf8Name string
+	Name     Value
+
+	Method Callback
+	Getter Callback
+	Setter Callback
+	Value  Value
+
+	Attributes PropertyAttributes
+}
@@ -38,6 +38,8 @@ const (
 	StatusArraybufferExpected           Status = C.napi_arraybuffer_expected
 	StatusDetachableArraybufferExpected Status = C.napi_detachable_arraybuffer_expected
 	StatusWouldDeadlock                 Status = C.napi_would_deadlock
+	StatusNoExternalBuffersAllowed      Status = C.napi_no_external_buffers_allowed
+	StatusCannotRunJs                   Status = C.napi_cannot_run_js
 )
 
 func (s Status) String() string {
@@ -86,6 +88,10 @@ func (s Status) String() string {
 		return "napi_detachable_arraybuffer_expected"
 	case StatusWouldDeadlock:
 		return "napi_would_deadlock"
+	case StatusNoExternalBuffersAllowed:
+		return "napi_no_external_buffers_allowed"
+	case StatusCannotRunJs:
+		return "napi_cannot_run_js"
 	}
 
 	return "napi_go_status_unknown"
@@ -103,6 +109,26 @@ func (s Status) AsError() error {
 	return StatusError(s)
 }
 
+// AsErrorFor is like AsError, but returns an ExtendedError for the call to op
+// that returned s, e.g. "napi_set_property", including the information
+// reported by napi_get_last_error_info. It must be called right after that
+// call, since the next Node-API call made on env overwrites the information.
+func (s Status) AsErrorFor(env Env, op string) error {
+	if s == StatusOK {
+		return nil
+	}
+
+	err, st := GetLastErrorInfo(env)
+	if st != StatusOK || err == nil || err.Status != s {
+		// the information is for another call, so only report what is known
+		err = &ExtendedError{}
+	}
+
+	err.Op = op
+	err.Status = s
+	return err
+}
+
 func (err StatusError) Error() string {
 	return fmt.Sprintf("napi_status error: %s", Status(err))
 }
@@ -0,0 +1,40 @@
+// Command define_reentry is a test addon that defines properties on an object
+// whose Proxy traps call back into the addon.
+package main
+
+import (
+	"github.com/akshayganeshen/napi-go"
+	"github.com/akshayganeshen/napi-go/entry"
+)
+
+func init() {
+	entry.Export("ping", Ping)
+	entry.Export("defineMethod", DefineMethod)
+}
+
+func Ping(env napi.Env, info napi.CallbackInfo) napi.Value {
+	result, _ := napi.CreateStringUtf8(env, "pong")
+	return result
+}
+
+// DefineMethod defines a method named "ping" on its first argument.
+func DefineMethod(env napi.Env, info napi.CallbackInfo) napi.Value {
+	cbInfo, st := napi.GetCbInfo(env, info)
+	if st != napi.StatusOK {
+		napi.ThrowError(env, "", st.AsError().Error())
+		return nil
+	}
+
+	st = napi.DefineProperties(env, cbInfo.Args[0], []napi.PropertyDescriptor{{
+		Utf8Name:   "ping",
+		Method:     Ping,
+		Attributes: napi.PropertyConfigurable,
+	}})
+	if st != napi.StatusOK && st != napi.StatusPendingException {
+		napi.ThrowError(env, "", st.AsError().Error())
+	}
+
+	return nil
+}
+
+func main() {}
@@ -0,0 +1,46 @@
+// Command extended_error is a test addon that reports the error for a failed
+// Node-API call.
+package main
+
+import (
+	"github.com/akshayganeshen/napi-go"
+	"github.com/akshayganeshen/napi-go/entry"
+)
+
+func init() {
+	entry.Export("getString", GetString)
+	entry.Export("getStringLate", GetStringLate)
+}
+
+// GetString gets its first argument as a string, which fails unless it is a
+// string, and returns the resulting error message.
+func GetString(env napi.Env, info napi.CallbackInfo) napi.Value {
+	cbInfo, _ := napi.GetCbInfo(env, info)
+
+	_, st := napi.GetValueStringUtf8(env, cbInfo.Args[0])
+	err := st.AsErrorFor(env, "napi_get_value_string_utf8")
+	if err == nil {
+		return nil
+	}
+
+	msg, _ := napi.CreateStringUtf8(env, err.Error())
+	return msg
+}
+
+// GetStringLate is like GetString, but makes another call before getting the
+// error, so the extended error information is no longer available.
+func GetStringLate(env napi.Env, info napi.CallbackInfo) napi.Value {
+	cbInfo, _ := napi.GetCbInfo(env, info)
+
+	_, st := napi.GetValueStringUtf8(env, cbInfo.Args[0])
+	napi.GetUndefined(env)
+	err := st.AsErrorFor(env, "napi_get_value_string_utf8")
+	if err == nil {
+		return nil
+	}
+
+	msg, _ := napi.CreateStringUtf8(env, err.Error())
+	return msg
+}
+
+func main() {}
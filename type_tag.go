@@ -0,0 +1,9 @@
+package napi
+
+// TypeTag is a 128-bit value used to mark objects, so they can later be
+// checked for having been created by a specific addon or with a specific
+// native type.
+type TypeTag struct {
+	Lower uint64
+	Upper uint64
+}
@@ -0,0 +1,70 @@
+package napi
+
+/*
+#include <node/node_api.h>
+*/
+import "C"
+
+type TypedArrayType int
+
+const (
+	TypedArrayTypeInt8         TypedArrayType = C.napi_int8_array
+	TypedArrayTypeUint8        TypedArrayType = C.napi_uint8_array
+	TypedArrayTypeUint8Clamped TypedArrayType = C.napi_uint8_clamped_array
+	TypedArrayTypeInt16        TypedArrayType = C.napi_int16_array
+	TypedArrayTypeUint16       TypedArrayType = C.napi_uint16_array
+	TypedArrayTypeInt32        TypedArrayType = C.napi_int32_array
+	TypedArrayTypeUint32       TypedArrayType = C.napi_uint32_array
+	TypedArrayTypeFloat32      TypedArrayType = C.napi_float32_array
+	TypedArrayTypeFloat64      TypedArrayType = C.napi_float64_array
+	TypedArrayTypeBigint64     TypedArrayType = C.napi_bigint64_array
+	TypedArrayTypeBiguint64    TypedArrayType = C.napi_biguint64_array
+)
+
+func (t TypedArrayType) String() string {
+	switch t {
+	case TypedArrayTypeInt8:
+		return "Int8Array"
+	case TypedArrayTypeUint8:
+		return "Uint8Array"
+	case TypedArrayTypeUint8Clamped:
+		return "Uint8ClampedArray"
+	case TypedArrayTypeInt16:
+		return "Int16Array"
+	case TypedArrayTypeUint16:
+		return "Uint16Array"
+	case TypedArrayTypeInt32:
+		return "Int32Array"
+	case TypedArrayTypeUint32:
+		return "Uint32Array"
+	case TypedArrayTypeFloat32:
+		return "Float32Array"
+	case TypedArrayTypeFloat64:
+		return "Float64Array"
+	case TypedArrayTypeBigint64:
+		return "BigInt64Array"
+	case TypedArrayTypeBiguint64:
+		return "BigUint64Array"
+
+	default:
+		return "unknown"
+	}
+}
+
+// ElementSize returns the number of bytes occupied by a single element of the
+// typed array, or 0 if the type is unknown.
+func (t TypedArrayType) ElementSize() int {
+	switch t {
+	case TypedArrayTypeInt8, TypedArrayTypeUint8, TypedArrayTypeUint8Clamped:
+		return 1
+	case TypedArrayTypeInt16, TypedArrayTypeUint16:
+		return 2
+	case TypedArrayTypeInt32, TypedArrayTypeUint32, TypedArrayTypeFloat32:
+		return 4
+	case TypedArrayTypeFloat64, TypedArrayTypeBigint64, TypedArrayTypeBiguint64:
+		return 8
+
+	default:
+		return 0
+	}
+}